@@ -2,9 +2,12 @@ package goodreads
 
 import (
 	"context"
-	"encoding/xml"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/BooleanCat/go-goodreads/param"
 )
 
 // An Author contains information about an author as defined by Goodreads.
@@ -28,39 +31,131 @@ type Author struct {
 	Books                []Book `xml:"books>book"`
 }
 
+// An AuthorFollowing contains information about a user following an author as defined by Goodreads.
+type AuthorFollowing struct {
+	ID        int         `xml:"id"`
+	Author    Author      `xml:"author"`
+	User      UserCompact `xml:"user"`
+	CreatedAt string      `xml:"created_at"`
+	UpdatedAt string      `xml:"updated_at"`
+}
+
 // AuthorShow returns author information given a Goodreads author ID.
 func (client Client) AuthorShow(ctx context.Context, id int) (Author, error) {
-	type goodreadsResponse struct {
+	var response struct {
 		Author Author `xml:"author"`
 	}
 
-	url := fmt.Sprintf("%s/author/show/%d.xml", client.getURL(), id)
+	if err := client.do(ctx, endpoint{path: fmt.Sprintf("/author/show/%d.xml", id)}, &response); err != nil {
+		return Author{}, err
+	}
+
+	return response.Author, nil
+}
+
+// An AuthorMatch is the author found by searching for an author's name as defined by Goodreads.
+type AuthorMatch struct {
+	ID   int    `xml:"id,attr"`
+	Name string `xml:"name"`
+	Link string `xml:"link"`
+}
+
+// SearchAuthors returns the author matching a name. An ErrNotFound is returned when no author matches.
+func (client Client) SearchAuthors(ctx context.Context, name string) (AuthorMatch, error) {
+	var response struct {
+		Author *AuthorMatch `xml:"author"`
+	}
+
+	e := endpoint{path: "/api/author_url/" + url.PathEscape(name)}
+	if err := client.do(ctx, e, &response); err != nil {
+		return AuthorMatch{}, err
+	}
 
-	request, err := client.newRequestWithKey(ctx, http.MethodGet, url, nil)
+	if response.Author == nil {
+		return AuthorMatch{}, ErrNotFound{}
+	}
+
+	return *response.Author, nil
+}
+
+// AuthorShowByName returns author information for the author matching a name. An ErrNotFound is returned when no
+// author matches.
+func (client Client) AuthorShowByName(ctx context.Context, name string) (Author, error) {
+	match, err := client.SearchAuthors(ctx, name)
 	if err != nil {
 		return Author{}, err
 	}
 
-	response, err := client.getClient().Do(request)
-	if err != nil {
-		return Author{}, fmt.Errorf("do request: %w", err)
+	return client.AuthorShow(ctx, match.ID)
+}
+
+// AuthorBooks returns a page of an author's books given a Goodreads author ID. Optional parameter param.Page may be
+// provided.
+func (client Client) AuthorBooks(ctx context.Context, id int, params ...param.Param) (BookList, error) {
+	var response struct {
+		Books BookList `xml:"author>books"`
 	}
 
-	defer closeIgnoreError(response.Body)
+	e := endpoint{path: fmt.Sprintf("/author/list/%d.xml", id), params: params}
+	if err := client.do(ctx, e, &response); err != nil {
+		return BookList{}, err
+	}
+
+	return response.Books, nil
+}
 
-	switch response.StatusCode {
-	case http.StatusNotFound:
-		return Author{}, ErrNotFound{}
-	case http.StatusOK:
-		break
-	default:
-		return Author{}, ErrUnexpectedResponse{Code: response.StatusCode}
+// AuthorBooksPager returns a Pager over every book of AuthorBooks. Each item is a Book.
+func (client Client) AuthorBooksPager(id int, params ...param.Param) *Pager {
+	return NewPager(func(ctx context.Context, page int) ([]interface{}, int, int, error) {
+		books, err := client.AuthorBooks(ctx, id, withPage(params, page)...)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+
+		return books.items(), books.End, books.Total, nil
+	})
+}
+
+// AuthorFollowingShow returns a user's following of an author given a Goodreads author following ID.
+func (client Client) AuthorFollowingShow(ctx context.Context, id int) (AuthorFollowing, error) {
+	var response struct {
+		AuthorFollowing AuthorFollowing `xml:"author_following"`
 	}
 
-	var author goodreadsResponse
-	if err := xml.NewDecoder(response.Body).Decode(&author); err != nil {
-		return Author{}, fmt.Errorf("decode response: %w", err)
+	e := endpoint{path: fmt.Sprintf("/author_followings/%d", id), params: []param.Param{formatXML}, signed: true}
+	if err := client.do(ctx, e, &response); err != nil {
+		return AuthorFollowing{}, err
 	}
 
-	return author.Author, nil
+	return response.AuthorFollowing, nil
+}
+
+// FollowAuthor follows an author, given a Goodreads author ID, as the user that authorized the client's access token.
+// The returned AuthorFollowing's ID may be given to UnfollowAuthor.
+func (client Client) FollowAuthor(ctx context.Context, authorID int) (AuthorFollowing, error) {
+	var response struct {
+		AuthorFollowing AuthorFollowing `xml:"author_following"`
+	}
+
+	e := endpoint{
+		method: http.MethodPost,
+		path:   "/author_followings",
+		params: []param.Param{formatXML, set("id", strconv.Itoa(authorID))},
+		signed: true,
+	}
+	if err := client.do(ctx, e, &response); err != nil {
+		return AuthorFollowing{}, err
+	}
+
+	return response.AuthorFollowing, nil
+}
+
+// UnfollowAuthor stops following an author given a Goodreads author following ID.
+func (client Client) UnfollowAuthor(ctx context.Context, id int) error {
+	return client.do(ctx, endpoint{
+		method: http.MethodDelete,
+		path:   fmt.Sprintf("/author_followings/%d", id),
+		params: []param.Param{formatXML},
+		signed: true,
+	}, nil)
 }
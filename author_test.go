@@ -9,14 +9,13 @@ import (
 	"testing"
 
 	"github.com/BooleanCat/go-goodreads"
-	"github.com/BooleanCat/go-goodreads/httputils"
 	"github.com/BooleanCat/go-goodreads/internal/assert"
 	"github.com/BooleanCat/go-goodreads/internal/fakes"
+	"github.com/BooleanCat/go-goodreads/param"
 )
 
 func ExampleClient_AuthorShow() {
-	transport := httputils.DripLimit(http.DefaultTransport, ticker)
-	client := goodreads.Client{Client: &http.Client{Transport: transport}}
+	client := goodreads.Client{Client: &http.Client{Transport: rateLimit}}
 
 	book, err := client.AuthorShow(context.Background(), 4764)
 	if err != nil {
@@ -123,6 +122,194 @@ func TestClient_AuthorShow_NotFound(t *testing.T) {
 	assert.True(t, goodreads.IsNotFound(err))
 }
 
+func TestClient_SearchAuthors(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewBufferString(authorURLResponseBody)),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+
+	match, err := client.SearchAuthors(context.Background(), "Baz O'Qux/Quux")
+	assert.Nil(t, err)
+	assert.Equal(t, match, goodreads.AuthorMatch{ID: 123, Name: "Baz O'Qux", Link: "https://foo.com/author"})
+
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+	request := transport.RoundTripArgsForCall(0)
+	assert.Equal(t, request.Method, http.MethodGet)
+	assert.Equal(t, request.URL.String(), "https://www.goodreads.com/api/author_url/Baz%20O%27Qux%2FQuux?key=key")
+}
+
+func TestClient_SearchAuthors_NoMatch(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewBufferString(`<GoodreadsResponse></GoodreadsResponse>`)),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+
+	_, err := client.SearchAuthors(context.Background(), "Nobody")
+	assert.True(t, goodreads.IsNotFound(err))
+}
+
+func TestClient_AuthorShowByName(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturnsOnCall(0, &http.Response{
+		Body:       ioutil.NopCloser(bytes.NewBufferString(authorURLResponseBody)),
+		StatusCode: http.StatusOK,
+	}, nil)
+	transport.RoundTripReturnsOnCall(1, &http.Response{
+		Body:       ioutil.NopCloser(bytes.NewBufferString(authorShowResponseBody)),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+
+	author, err := client.AuthorShowByName(context.Background(), "Baz")
+	assert.Nil(t, err)
+	assert.Equal(t, author.Name, "Baz")
+
+	assert.Equal(t, transport.RoundTripCallCount(), 2)
+	assert.Equal(t, transport.RoundTripArgsForCall(0).URL.String(), "https://www.goodreads.com/api/author_url/Baz?key=key")
+	assert.Equal(t, transport.RoundTripArgsForCall(1).URL.String(),
+		"https://www.goodreads.com/author/show/123.xml?key=key")
+}
+
+func TestClient_AuthorShowByName_NoMatch(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewBufferString(`<GoodreadsResponse></GoodreadsResponse>`)),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+
+	_, err := client.AuthorShowByName(context.Background(), "Nobody")
+	assert.True(t, goodreads.IsNotFound(err))
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+}
+
+const authorURLResponseBody string = `
+	<GoodreadsResponse>
+		<author id="123">
+			<name>Baz O'Qux</name>
+			<link>https://foo.com/author</link>
+		</author>
+	</GoodreadsResponse>
+`
+
+func TestClient_AuthorFollowingShow(t *testing.T) {
+	responseBody := bytes.NewBufferString(authorFollowingResponseBody)
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(responseBody),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := signedClient(transport)
+
+	following, err := client.AuthorFollowingShow(context.Background(), 66)
+	assert.Nil(t, err)
+	assert.Equal(t, following, authorFollowingFixture())
+
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+	request := transport.RoundTripArgsForCall(0)
+	assert.Equal(t, request.Method, http.MethodGet)
+	assert.Equal(t, request.URL.String(), "https://www.goodreads.com/author_followings/66?format=xml&key=key")
+	assertSignedForm(t, request, "")
+}
+
+func TestClient_AuthorFollowingShow_NotFound(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		StatusCode: http.StatusNotFound,
+	}, nil)
+
+	client := signedClient(transport)
+
+	_, err := client.AuthorFollowingShow(context.Background(), 66)
+	assert.True(t, goodreads.IsNotFound(err))
+}
+
+func TestClient_FollowAuthor(t *testing.T) {
+	responseBody := bytes.NewBufferString(authorFollowingResponseBody)
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(responseBody),
+		StatusCode: http.StatusCreated,
+	}, nil)
+
+	client := signedClient(transport)
+
+	following, err := client.FollowAuthor(context.Background(), 123)
+	assert.Nil(t, err)
+	assert.Equal(t, following, authorFollowingFixture())
+
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+	request := transport.RoundTripArgsForCall(0)
+	assert.Equal(t, request.Method, http.MethodPost)
+	assert.Equal(t, request.URL.String(), "https://www.goodreads.com/author_followings?format=xml&id=123&key=key")
+	assertSignedForm(t, request, "")
+}
+
+func TestClient_FollowAuthor_AccessTokenNotSet(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key", Secret: "secret"}
+
+	_, err := client.FollowAuthor(context.Background(), 123)
+	assert.Equal(t, err, goodreads.ErrAccessTokenNotSet{})
+	assert.Equal(t, transport.RoundTripCallCount(), 0)
+}
+
+func TestClient_UnfollowAuthor(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(new(bytes.Buffer)),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := signedClient(transport)
+
+	assert.Nil(t, client.UnfollowAuthor(context.Background(), 66))
+
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+	request := transport.RoundTripArgsForCall(0)
+	assert.Equal(t, request.Method, http.MethodDelete)
+	assert.Equal(t, request.URL.String(), "https://www.goodreads.com/author_followings/66?format=xml&key=key")
+	assertSignedForm(t, request, "")
+}
+
+const authorFollowingResponseBody string = `
+	<GoodreadsResponse>
+		<author_following>
+			<id>66</id>
+			<author>
+				<id>123</id>
+				<name>Baz</name>
+				<author_followers_count>51</author_followers_count>
+			</author>
+			<user>
+				<id>213</id>
+				<name>Foo Bar</name>
+			</user>
+			<created_at>Sun Jan 05 10:00:00 -0800 2020</created_at>
+			<updated_at>Sun Jan 05 10:00:00 -0800 2020</updated_at>
+		</author_following>
+	</GoodreadsResponse>
+`
+
+func authorFollowingFixture() goodreads.AuthorFollowing {
+	return goodreads.AuthorFollowing{
+		ID:        66,
+		Author:    goodreads.Author{ID: 123, Name: "Baz", AuthorFollowersCount: 51},
+		User:      goodreads.UserCompact{ID: 213, Name: "Foo Bar"},
+		CreatedAt: "Sun Jan 05 10:00:00 -0800 2020",
+		UpdatedAt: "Sun Jan 05 10:00:00 -0800 2020",
+	}
+}
+
 const authorShowResponseBody string = `
 	<goodreads_response>
 		<author>
@@ -150,3 +337,94 @@ const authorShowResponseBody string = `
 		</author>
 	</goodreads_response>
 `
+
+func TestClient_AuthorBooks(t *testing.T) {
+	responseBody := bytes.NewBufferString(authorBooksResponseBody)
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(responseBody),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+
+	books, err := client.AuthorBooks(context.Background(), 123, param.Page(2))
+	assert.Nil(t, err)
+	assert.Equal(t, books, goodreads.BookList{
+		Start: 31,
+		End:   32,
+		Total: 32,
+		Books: []goodreads.Book{{ID: 1, Title: "Mediocre Book"}, {ID: 2, Title: "Good Book"}},
+	})
+
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+	request := transport.RoundTripArgsForCall(0)
+	assert.Equal(t, request.Method, http.MethodGet)
+	assert.Equal(t, request.URL.String(), "https://www.goodreads.com/author/list/123.xml?key=key&page=2")
+}
+
+func TestClient_AuthorBooks_NotFound(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		StatusCode: http.StatusNotFound,
+	}, nil)
+
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+
+	_, err := client.AuthorBooks(context.Background(), 123)
+	assert.True(t, goodreads.IsNotFound(err))
+}
+
+func TestClient_AuthorBooksPager(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturnsOnCall(0, &http.Response{
+		Body:       ioutil.NopCloser(bytes.NewBufferString(authorBooksFirstPageResponseBody)),
+		StatusCode: http.StatusOK,
+	}, nil)
+	transport.RoundTripReturnsOnCall(1, &http.Response{
+		Body:       ioutil.NopCloser(bytes.NewBufferString(authorBooksResponseBody)),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+	pager := client.AuthorBooksPager(123)
+
+	var ids []int
+	for pager.Next(context.Background()) {
+		ids = append(ids, pager.Item().(goodreads.Book).ID)
+	}
+
+	assert.Nil(t, pager.Err())
+	assert.Equal(t, ids, []int{0, 1, 2})
+	assert.Equal(t, transport.RoundTripCallCount(), 2)
+	assert.EndsWith(t, transport.RoundTripArgsForCall(1).URL.String(), "key=key&page=2")
+}
+
+const authorBooksFirstPageResponseBody string = `
+	<GoodreadsResponse>
+		<author>
+			<books start="1" end="30" total="32">
+				<book><id>0</id></book>
+			</books>
+		</author>
+	</GoodreadsResponse>
+`
+
+const authorBooksResponseBody string = `
+	<GoodreadsResponse>
+		<author>
+			<id>123</id>
+			<name>Baz</name>
+			<books start="31" end="32" total="32">
+				<book>
+					<id>1</id>
+					<title>Mediocre Book</title>
+				</book>
+				<book>
+					<id>2</id>
+					<title>Good Book</title>
+				</book>
+			</books>
+		</author>
+	</GoodreadsResponse>
+`
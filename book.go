@@ -2,9 +2,7 @@ package goodreads
 
 import (
 	"context"
-	"encoding/xml"
 	"fmt"
-	"net/http"
 
 	"github.com/BooleanCat/go-goodreads/param"
 )
@@ -43,34 +41,66 @@ type Book struct {
 	SimilarBooks       []Book       `xml:"similar_books>book"`
 	AverageRating      float32      `xml:"average_rating"`
 	IsEbook            bool         `xml:"is_ebook"`
+	ReviewsWidget      string       `xml:"reviews_widget"`
+}
+
+// A BookList is a page of books as defined by Goodreads.
+type BookList struct {
+	Start int    `xml:"start,attr"`
+	End   int    `xml:"end,attr"`
+	Total int    `xml:"total,attr"`
+	Books []Book `xml:"book"`
+}
+
+func (list BookList) items() []interface{} {
+	items := make([]interface{}, len(list.Books))
+	for i, book := range list.Books {
+		items[i] = book
+	}
+
+	return items
 }
 
 // A Work contains information about a work as defined by Goodreads.
 type Work struct {
-	ID                             int64  `xml:"id"`
-	BooksCount                     int64  `xml:"books_count"`
-	BestBookID                     int64  `xml:"best_book_id"`
-	ReviewsCount                   int64  `xml:"reviews_count"`
-	RatingsSum                     int64  `xml:"ratings_sum"`
-	RatingsCount                   int64  `xml:"ratings_count"`
-	TextReviewsCount               int64  `xml:"text_reviews_count"`
-	OriginalPublicationYear        int64  `xml:"original_publication_year"`
-	OriginalPublicationMonth       int64  `xml:"original_publication_month"`
-	OriginalPublicationDay         int64  `xml:"original_publication_day"`
-	OriginalTitle                  string `xml:"original_title"`
-	OriginalLanguageID             int64  `xml:"original_language_id"`
-	MediaType                      string `xml:"media_type"`
-	RatingDist                     string `xml:"rating_dist"`
-	DescUserID                     int64  `xml:"desc_user_id"`
-	DefaultChapteringBookID        int64  `xml:"default_chaptering_book_id"`
-	DefaultDescriptionLanguageCode string `xml:"default_description_language_code"`
-	WorkURI                        string `xml:"work_uri"`
+	ID                             int64    `xml:"id"`
+	BooksCount                     int64    `xml:"books_count"`
+	BestBookID                     int64    `xml:"best_book_id"`
+	ReviewsCount                   int64    `xml:"reviews_count"`
+	RatingsSum                     int64    `xml:"ratings_sum"`
+	RatingsCount                   int64    `xml:"ratings_count"`
+	TextReviewsCount               int64    `xml:"text_reviews_count"`
+	OriginalPublicationYear        int64    `xml:"original_publication_year"`
+	OriginalPublicationMonth       int64    `xml:"original_publication_month"`
+	OriginalPublicationDay         int64    `xml:"original_publication_day"`
+	OriginalTitle                  string   `xml:"original_title"`
+	OriginalLanguageID             int64    `xml:"original_language_id"`
+	MediaType                      string   `xml:"media_type"`
+	RatingDist                     string   `xml:"rating_dist"`
+	DescUserID                     int64    `xml:"desc_user_id"`
+	DefaultChapteringBookID        int64    `xml:"default_chaptering_book_id"`
+	DefaultDescriptionLanguageCode string   `xml:"default_description_language_code"`
+	WorkURI                        string   `xml:"work_uri"`
+	AverageRating                  float32  `xml:"average_rating"`
+	BestBook                       BestBook `xml:"best_book"`
+}
+
+// A BestBook contains summary information about the best book of a work as defined by Goodreads.
+type BestBook struct {
+	ID            int    `xml:"id"`
+	Title         string `xml:"title"`
+	Author        Author `xml:"author"`
+	ImageURL      string `xml:"image_url"`
+	SmallImageURL string `xml:"small_image_url"`
 }
 
 // A Shelf contains information about a shelf as defined by Goodreads.
 type Shelf struct {
-	Name  string `xml:"name,attr"`
-	Count string `xml:"count,attr"`
+	ID        int    `xml:"id,attr"`
+	Name      string `xml:"name,attr"`
+	Count     string `xml:"count,attr"`
+	Exclusive bool   `xml:"exclusive,attr"`
+	Sortable  bool   `xml:"sortable,attr"`
 }
 
 // A Link contains information about a link as defined by Goodreads.
@@ -85,53 +115,178 @@ type SeriesWork struct {
 	ID           int    `xml:"id"`
 	UserPosition int    `xml:"user_position"`
 	Series       Series `xml:"series"`
+	Work         Work   `xml:"work"`
 }
 
 // A Series contains information about a series as defined by Goodreads.
 type Series struct {
-	ID               int    `xml:"id"`
-	Title            string `xml:"title"`
-	Description      string `xml:"description"`
-	Note             string `xml:"note"`
-	SeriesWorksCount int    `xml:"series_works_count"`
-	PrimaryWorkCount int    `xml:"primary_work_count"`
-	Numbered         bool   `xml:"numbered"`
+	ID               int          `xml:"id"`
+	Title            string       `xml:"title"`
+	Description      string       `xml:"description"`
+	Note             string       `xml:"note"`
+	SeriesWorksCount int          `xml:"series_works_count"`
+	PrimaryWorkCount int          `xml:"primary_work_count"`
+	Numbered         bool         `xml:"numbered"`
+	SeriesWorks      []SeriesWork `xml:"series_works>series_work"`
+}
+
+// ReviewCounts contains the rating and review statistics of a book as defined by Goodreads.
+type ReviewCounts struct {
+	ID                   int     `json:"id"`
+	ISBN                 string  `json:"isbn"`
+	ISBN13               string  `json:"isbn13"`
+	RatingsCount         int     `json:"ratings_count"`
+	ReviewsCount         int     `json:"reviews_count"`
+	TextReviewsCount     int     `json:"text_reviews_count"`
+	WorkRatingsCount     int     `json:"work_ratings_count"`
+	WorkReviewsCount     int     `json:"work_reviews_count"`
+	WorkTextReviewsCount int     `json:"work_text_reviews_count"`
+	AverageRating        float32 `json:"average_rating,string"`
 }
 
 // BookShow fetches reviews for a book given a Goodreads book ID. Optional parameters OptionTextOnly or OptionRating
 // may be provided.
 func (client Client) BookShow(ctx context.Context, id int, params ...param.Param) (Book, error) {
-	type goodreadsResponse struct {
+	var response struct {
 		Book Book `xml:"book"`
 	}
 
-	url := fmt.Sprintf("%s/book/show/%d.xml", client.getURL(), id)
+	e := endpoint{path: fmt.Sprintf("/book/show/%d.xml", id), params: params}
+	if err := client.do(ctx, e, &response); err != nil {
+		return Book{}, err
+	}
+
+	return response.Book, nil
+}
 
-	request, err := client.newRequestWithKey(ctx, http.MethodGet, url, nil)
+// BookShowByISBN fetches reviews for a book given its ISBN-10 or ISBN-13. Optional parameters param.TextOnly or
+// param.Rating may be provided.
+func (client Client) BookShowByISBN(ctx context.Context, isbn string, params ...param.Param) (Book, error) {
+	isbn, err := NormalizeISBN(isbn)
 	if err != nil {
 		return Book{}, err
 	}
 
-	response, err := client.getClient().Do(param.Apply(request, params...))
-	if err != nil {
-		return Book{}, fmt.Errorf("do request: %w", err)
+	var response struct {
+		Book Book `xml:"book"`
 	}
 
-	defer closeIgnoreError(response.Body)
+	e := endpoint{path: "/book/isbn/" + isbn, params: append([]param.Param{formatXML}, params...)}
+	if err := client.do(ctx, e, &response); err != nil {
+		return Book{}, err
+	}
+
+	return response.Book, nil
+}
 
-	switch response.StatusCode {
-	case http.StatusNotFound:
+// BookShowByTitle fetches reviews for the book best matching a title. Optional parameter param.Author may be
+// provided to narrow the match, as may param.TextOnly or param.Rating. An ErrNotFound is returned when no book matches.
+func (client Client) BookShowByTitle(ctx context.Context, title string, params ...param.Param) (Book, error) {
+	var response struct {
+		Book *Book `xml:"book"`
+	}
+
+	e := endpoint{path: "/book/title.xml", params: append([]param.Param{set("title", title)}, params...)}
+	if err := client.do(ctx, e, &response); err != nil {
+		return Book{}, err
+	}
+
+	if response.Book == nil || response.Book.ID == 0 {
 		return Book{}, ErrNotFound{}
-	case http.StatusOK:
-		break
-	default:
-		return Book{}, ErrUnexpectedResponse{Code: response.StatusCode}
 	}
 
-	var book goodreadsResponse
-	if err := xml.NewDecoder(response.Body).Decode(&book); err != nil {
-		return Book{}, fmt.Errorf("decode response: %w", err)
+	return *response.Book, nil
+}
+
+// ISBNToID returns the Goodreads book IDs of the given ISBN-10s or ISBN-13s, in the same order. The ID is 0 for any
+// ISBN that Goodreads does not know.
+func (client Client) ISBNToID(ctx context.Context, isbns ...string) ([]int, error) {
+	if len(isbns) == 0 {
+		return nil, nil
+	}
+
+	isbns, err := normalizeISBNs(isbns)
+	if err != nil {
+		return nil, err
+	}
+
+	var response string
+
+	e := endpoint{path: "/book/isbn_to_id", params: []param.Param{param.ISBN(isbns...)}, decode: decodeText}
+	if err := client.do(ctx, e, &response); err != nil {
+		return nil, err
+	}
+
+	return parseIDs(response)
+}
+
+// BookIDToWorkID returns the Goodreads work IDs of the given Goodreads book IDs, in the same order. The ID is 0 for any
+// book that Goodreads does not know.
+func (client Client) BookIDToWorkID(ctx context.Context, bookIDs ...int) ([]int64, error) {
+	if len(bookIDs) == 0 {
+		return nil, nil
+	}
+
+	var response struct {
+		WorkIDs []int64 `xml:"work-ids>item"`
+	}
+
+	if err := client.do(ctx, endpoint{path: "/book/id_to_work_id/" + joinIDs(bookIDs)}, &response); err != nil {
+		return nil, err
+	}
+
+	return response.WorkIDs, nil
+}
+
+// maxReviewCountsISBNs is the most ISBNs Goodreads accepts in a single review counts request.
+const maxReviewCountsISBNs = 1000
+
+// BookReviewCounts returns review statistics for the books with the given ISBN-10s or ISBN-13s. Any number of ISBNs may
+// be given; they are requested in batches no larger than Goodreads allows and the results are returned in batch order.
+// Books unknown to Goodreads are omitted from the results, and ErrNotFound is returned if none are known.
+func (client Client) BookReviewCounts(ctx context.Context, ids ...string) ([]ReviewCounts, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	isbns, err := normalizeISBNs(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	var counts []ReviewCounts
+
+	for len(isbns) > 0 {
+		n := len(isbns)
+		if n > maxReviewCountsISBNs {
+			n = maxReviewCountsISBNs
+		}
+
+		batch, err := client.bookReviewCounts(ctx, isbns[:n])
+		if err != nil && !IsNotFound(err) {
+			return nil, err
+		}
+
+		counts = append(counts, batch...)
+		isbns = isbns[n:]
+	}
+
+	if len(counts) == 0 {
+		return nil, ErrNotFound{}
+	}
+
+	return counts, nil
+}
+
+func (client Client) bookReviewCounts(ctx context.Context, isbns []string) ([]ReviewCounts, error) {
+	var response struct {
+		Books []ReviewCounts `json:"books"`
+	}
+
+	e := endpoint{path: "/book/review_counts.json", params: []param.Param{param.ISBNs(isbns...)}, decode: decodeJSON}
+	if err := client.do(ctx, e, &response); err != nil {
+		return nil, err
 	}
 
-	return book.Book, nil
+	return response.Books, nil
 }
@@ -6,18 +6,17 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/BooleanCat/go-goodreads"
-	"github.com/BooleanCat/go-goodreads/httputils"
 	"github.com/BooleanCat/go-goodreads/internal/assert"
 	"github.com/BooleanCat/go-goodreads/internal/fakes"
 	"github.com/BooleanCat/go-goodreads/param"
 )
 
 func ExampleClient_BookShow() {
-	transport := httputils.DripLimit(http.DefaultTransport, ticker)
-	client := goodreads.Client{Client: &http.Client{Transport: transport}}
+	client := goodreads.Client{Client: &http.Client{Transport: rateLimit}}
 
 	book, err := client.BookShow(context.Background(), 36402034, param.TextOnly)
 	if err != nil {
@@ -124,6 +123,267 @@ func TestClient_BookShowNotFound(t *testing.T) {
 	assert.True(t, goodreads.IsNotFound(err))
 }
 
+func TestClient_BookShowByISBN(t *testing.T) {
+	responseBody := bytes.NewBufferString(bookShowResponseBody)
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(responseBody),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+
+	book, err := client.BookShowByISBN(context.Background(), "0-306-40615-2", param.TextOnly)
+	assert.Nil(t, err)
+	assert.Equal(t, book, bookFixture())
+
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+	request := transport.RoundTripArgsForCall(0)
+	assert.Equal(t, request.Method, http.MethodGet)
+	assert.Equal(t, request.URL.String(),
+		"https://www.goodreads.com/book/isbn/9780306406157?format=xml&key=key&text_only=true")
+}
+
+func TestClient_BookShowByISBN_InvalidISBN(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+
+	_, err := client.BookShowByISBN(context.Background(), "0-306-40615-3")
+	assert.Equal(t, err, goodreads.ErrInvalidISBN{ISBN: "0-306-40615-3"})
+	assert.Equal(t, transport.RoundTripCallCount(), 0)
+}
+
+func TestClient_BookShowByISBN_NotFound(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		StatusCode: http.StatusNotFound,
+	}, nil)
+
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+
+	_, err := client.BookShowByISBN(context.Background(), "9780306406157")
+	assert.True(t, goodreads.IsNotFound(err))
+}
+
+func TestClient_BookShowByTitle(t *testing.T) {
+	responseBody := bytes.NewBufferString(bookShowResponseBody)
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(responseBody),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+
+	book, err := client.BookShowByTitle(context.Background(), "baz bar", param.Author("Foo Bar"))
+	assert.Nil(t, err)
+	assert.Equal(t, book, bookFixture())
+
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+	request := transport.RoundTripArgsForCall(0)
+	assert.Equal(t, request.Method, http.MethodGet)
+	assert.Equal(t, request.URL.String(),
+		"https://www.goodreads.com/book/title.xml?author=Foo+Bar&key=key&title=baz+bar")
+}
+
+func TestClient_BookShowByTitle_NotFound(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		StatusCode: http.StatusNotFound,
+	}, nil)
+
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+
+	_, err := client.BookShowByTitle(context.Background(), "baz bar")
+	assert.True(t, goodreads.IsNotFound(err))
+}
+
+func TestClient_BookShowByTitle_NoMatch(t *testing.T) {
+	for _, body := range []string{
+		`<GoodreadsResponse></GoodreadsResponse>`,
+		`<GoodreadsResponse><book><id></id></book></GoodreadsResponse>`,
+	} {
+		transport := new(fakes.FakeRoundTripper)
+		transport.RoundTripReturns(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			StatusCode: http.StatusOK,
+		}, nil)
+
+		client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+
+		_, err := client.BookShowByTitle(context.Background(), "baz bar")
+		assert.True(t, goodreads.IsNotFound(err))
+	}
+}
+
+func TestClient_ISBNToID(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewBufferString("123,,456\n")),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+
+	ids, err := client.ISBNToID(context.Background(), "0-306-40615-2", "9780804429573", "080442957X")
+	assert.Nil(t, err)
+	assert.Equal(t, ids, []int{123, 0, 456})
+
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+	request := transport.RoundTripArgsForCall(0)
+	assert.Equal(t, request.URL.Path, "/book/isbn_to_id")
+	assert.Equal(t, request.URL.Query().Get("isbn"), "9780306406157,9780804429573,9780804429573")
+}
+
+func TestClient_ISBNToID_InvalidISBN(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+
+	_, err := client.ISBNToID(context.Background(), "9780306406157", "foo")
+	assert.Equal(t, err, goodreads.ErrInvalidISBN{ISBN: "foo"})
+	assert.Equal(t, transport.RoundTripCallCount(), 0)
+}
+
+func TestClient_ISBNToID_DecodeFails(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewBufferString("<html>")),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+
+	_, err := client.ISBNToID(context.Background(), "9780306406157")
+	assert.ErrorMatches(t, err, `^decode response: `)
+}
+
+func TestClient_BookIDToWorkID(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewBufferString(bookIDToWorkIDResponseBody)),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+
+	ids, err := client.BookIDToWorkID(context.Background(), 123, 124, 125)
+	assert.Nil(t, err)
+	assert.Equal(t, ids, []int64{42, 42, 0})
+
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+	request := transport.RoundTripArgsForCall(0)
+	assert.Equal(t, request.Method, http.MethodGet)
+	assert.Equal(t, request.URL.String(), "https://www.goodreads.com/book/id_to_work_id/123,124,125?key=key")
+}
+
+func TestClient_BookIDToWorkID_NoIDs(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+
+	ids, err := client.BookIDToWorkID(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, len(ids), 0)
+	assert.Equal(t, transport.RoundTripCallCount(), 0)
+}
+
+func TestClient_BookReviewCounts(t *testing.T) {
+	responseBody := bytes.NewBufferString(bookReviewCountsResponseBody)
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(responseBody),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+
+	counts, err := client.BookReviewCounts(context.Background(), "0-306-40615-2")
+	assert.Nil(t, err)
+	assert.Equal(t, counts, []goodreads.ReviewCounts{{
+		ID:                   123,
+		ISBN:                 "0306406152",
+		ISBN13:               "9780306406157",
+		RatingsCount:         98,
+		ReviewsCount:         120,
+		TextReviewsCount:     42,
+		WorkRatingsCount:     400,
+		WorkReviewsCount:     653,
+		WorkTextReviewsCount: 50,
+		AverageRating:        4.09,
+	}})
+
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+	request := transport.RoundTripArgsForCall(0)
+	assert.Equal(t, request.Method, http.MethodGet)
+	assert.Equal(t, request.URL.String(),
+		"https://www.goodreads.com/book/review_counts.json?isbns=9780306406157&key=key")
+}
+
+func TestClient_BookReviewCounts_Batches(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturnsOnCall(0, &http.Response{
+		Body:       ioutil.NopCloser(bytes.NewBufferString(`{"books": [{"id": 1}, {"id": 2}]}`)),
+		StatusCode: http.StatusOK,
+	}, nil)
+	transport.RoundTripReturnsOnCall(1, &http.Response{
+		Body:       ioutil.NopCloser(new(bytes.Buffer)),
+		StatusCode: http.StatusNotFound,
+	}, nil)
+	transport.RoundTripReturnsOnCall(2, &http.Response{
+		Body:       ioutil.NopCloser(bytes.NewBufferString(`{"books": [{"id": 3}]}`)),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+
+	isbns := make([]string, 2001)
+	for i := range isbns {
+		isbns[i] = "9780306406157"
+	}
+
+	counts, err := client.BookReviewCounts(context.Background(), isbns...)
+	assert.Nil(t, err)
+	assert.Equal(t, counts, []goodreads.ReviewCounts{{ID: 1}, {ID: 2}, {ID: 3}})
+
+	assert.Equal(t, transport.RoundTripCallCount(), 3)
+	assert.Equal(t, len(strings.Split(transport.RoundTripArgsForCall(0).URL.Query().Get("isbns"), ",")), 1000)
+	assert.Equal(t, len(strings.Split(transport.RoundTripArgsForCall(1).URL.Query().Get("isbns"), ",")), 1000)
+	assert.Equal(t, len(strings.Split(transport.RoundTripArgsForCall(2).URL.Query().Get("isbns"), ",")), 1)
+}
+
+func TestClient_BookReviewCounts_InvalidISBN(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+
+	_, err := client.BookReviewCounts(context.Background(), "9780306406157", "123")
+	assert.Equal(t, err, goodreads.ErrInvalidISBN{ISBN: "123"})
+	assert.Equal(t, transport.RoundTripCallCount(), 0)
+}
+
+func TestClient_BookReviewCounts_NotFound(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		StatusCode: http.StatusNotFound,
+	}, nil)
+
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+
+	_, err := client.BookReviewCounts(context.Background(), "9780306406157")
+	assert.True(t, goodreads.IsNotFound(err))
+}
+
+func TestClient_BookReviewCounts_DecodeFails(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewBufferString(`{"books": [{"average_rating": 4.09}]}`)),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+
+	_, err := client.BookReviewCounts(context.Background(), "9780306406157")
+	assert.ErrorMatches(t, err, `^decode response: `)
+}
+
 const bookShowResponseBody string = `
 	<goodreads_response>
 		<book>
@@ -215,6 +475,7 @@ const bookShowResponseBody string = `
 					<title>Baz</title>
 				</book>
 			</similar_books>
+			<reviews_widget><![CDATA[<div id="goodreads-widget"></div>]]></reviews_widget>
 		</book>
 	</goodreads_response>
 `
@@ -284,6 +545,36 @@ func bookFixture() goodreads.Book { //nolint:funlen
 				Numbered:         true,
 			}},
 		},
-		SimilarBooks: []goodreads.Book{{Title: "Baz"}},
+		SimilarBooks:  []goodreads.Book{{Title: "Baz"}},
+		ReviewsWidget: `<div id="goodreads-widget"></div>`,
 	}
 }
+
+const bookReviewCountsResponseBody string = `
+	{
+		"books": [
+			{
+				"id": 123,
+				"isbn": "0306406152",
+				"isbn13": "9780306406157",
+				"ratings_count": 98,
+				"reviews_count": 120,
+				"text_reviews_count": 42,
+				"work_ratings_count": 400,
+				"work_reviews_count": 653,
+				"work_text_reviews_count": 50,
+				"average_rating": "4.09"
+			}
+		]
+	}
+`
+
+const bookIDToWorkIDResponseBody string = `
+	<GoodreadsResponse>
+		<work-ids>
+			<item>42</item>
+			<item>42</item>
+			<item />
+		</work-ids>
+	</GoodreadsResponse>
+`
@@ -0,0 +1,161 @@
+package goodreads
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/BooleanCat/go-goodreads/param"
+)
+
+// A ResourceType is a type of resource that can be commented on.
+type ResourceType string
+
+// Types of resource that can be commented on, as documented by Goodreads. Quotes are commented on as
+// ResourceUserQuote and author followings as ResourceFanship.
+const (
+	ResourceAuthorBlogPost        ResourceType = "author_blog_post"
+	ResourceBlog                  ResourceType = "blog"
+	ResourceBookNewsPost          ResourceType = "book_news_post"
+	ResourceChapter               ResourceType = "chapter"
+	ResourceComment               ResourceType = "comment"
+	ResourceCommunityAnswer       ResourceType = "community_answer"
+	ResourceEventResponse         ResourceType = "event_response"
+	ResourceFanship               ResourceType = "fanship"
+	ResourceFriend                ResourceType = "friend"
+	ResourceGiveaway              ResourceType = "giveaway"
+	ResourceGiveawayRequest       ResourceType = "giveaway_request"
+	ResourceGroupUser             ResourceType = "group_user"
+	ResourceInterview             ResourceType = "interview"
+	ResourceLibrarianNote         ResourceType = "librarian_note"
+	ResourceLinkCollection        ResourceType = "link_collection"
+	ResourceList                  ResourceType = "list"
+	ResourceOwnedBook             ResourceType = "owned_book"
+	ResourcePhoto                 ResourceType = "photo"
+	ResourcePoll                  ResourceType = "poll"
+	ResourcePollVote              ResourceType = "poll_vote"
+	ResourceQueuedItem            ResourceType = "queued_item"
+	ResourceQuestion              ResourceType = "question"
+	ResourceQuestionUserStat      ResourceType = "question_user_stat"
+	ResourceQuiz                  ResourceType = "quiz"
+	ResourceQuizScore             ResourceType = "quiz_score"
+	ResourceRating                ResourceType = "rating"
+	ResourceReadStatus            ResourceType = "read_status"
+	ResourceRecommendation        ResourceType = "recommendation"
+	ResourceRecommendationRequest ResourceType = "recommendation_request"
+	ResourceReview                ResourceType = "review"
+	ResourceTopic                 ResourceType = "topic"
+	ResourceUser                  ResourceType = "user"
+	ResourceUserChallenge         ResourceType = "user_challenge"
+	ResourceUserFollowing         ResourceType = "user_following"
+	ResourceUserListChallenge     ResourceType = "user_list_challenge"
+	ResourceUserListVote          ResourceType = "user_list_vote"
+	ResourceUserQuote             ResourceType = "user_quote"
+	ResourceUserStatus            ResourceType = "user_status"
+	ResourceVideo                 ResourceType = "video"
+)
+
+func (resourceType ResourceType) validate() error {
+	switch resourceType {
+	case ResourceAuthorBlogPost, ResourceBlog, ResourceBookNewsPost, ResourceChapter, ResourceComment,
+		ResourceCommunityAnswer, ResourceEventResponse, ResourceFanship, ResourceFriend, ResourceGiveaway,
+		ResourceGiveawayRequest, ResourceGroupUser, ResourceInterview, ResourceLibrarianNote, ResourceLinkCollection,
+		ResourceList, ResourceOwnedBook, ResourcePhoto, ResourcePoll, ResourcePollVote, ResourceQueuedItem,
+		ResourceQuestion, ResourceQuestionUserStat, ResourceQuiz, ResourceQuizScore, ResourceRating,
+		ResourceReadStatus, ResourceRecommendation, ResourceRecommendationRequest, ResourceReview, ResourceTopic,
+		ResourceUser, ResourceUserChallenge, ResourceUserFollowing, ResourceUserListChallenge, ResourceUserListVote,
+		ResourceUserQuote, ResourceUserStatus, ResourceVideo:
+		return nil
+	default:
+		return ErrInvalidResourceType{ResourceType: resourceType}
+	}
+}
+
+// A Comment contains information about a comment as defined by Goodreads.
+type Comment struct {
+	ID        int         `xml:"id"`
+	Body      string      `xml:"body"`
+	CreatedAt string      `xml:"created_at"`
+	UpdatedAt string      `xml:"updated_at"`
+	User      UserCompact `xml:"user"`
+}
+
+// A CommentList is a page of comments as defined by Goodreads.
+type CommentList struct {
+	Start    int       `xml:"start,attr"`
+	End      int       `xml:"end,attr"`
+	Total    int       `xml:"total,attr"`
+	Comments []Comment `xml:"comment"`
+}
+
+func (list CommentList) items() []interface{} {
+	items := make([]interface{}, len(list.Comments))
+	for i, comment := range list.Comments {
+		items[i] = comment
+	}
+
+	return items
+}
+
+// CommentList returns a page of the comments on a resource given its type and Goodreads ID. Optional parameter
+// param.Page may be provided.
+func (client Client) CommentList(
+	ctx context.Context, resourceType ResourceType, id int, params ...param.Param,
+) (CommentList, error) {
+	if err := resourceType.validate(); err != nil {
+		return CommentList{}, err
+	}
+
+	var response struct {
+		Comments CommentList `xml:"comments"`
+	}
+
+	e := endpoint{
+		path:   "/comment/index.xml",
+		params: append([]param.Param{set("type", string(resourceType)), set("id", strconv.Itoa(id))}, params...),
+		signed: true,
+	}
+	if err := client.do(ctx, e, &response); err != nil {
+		return CommentList{}, err
+	}
+
+	return response.Comments, nil
+}
+
+// CommentListPager returns a Pager over every comment of CommentList. Each item is a Comment.
+func (client Client) CommentListPager(resourceType ResourceType, id int, params ...param.Param) *Pager {
+	return NewPager(func(ctx context.Context, page int) ([]interface{}, int, int, error) {
+		comments, err := client.CommentList(ctx, resourceType, id, withPage(params, page)...)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+
+		return comments.items(), comments.End, comments.Total, nil
+	})
+}
+
+// CommentCreate comments on a resource, given its type and Goodreads ID, as the user that authorized the client's
+// access token.
+func (client Client) CommentCreate(
+	ctx context.Context, resourceType ResourceType, id int, body string,
+) (Comment, error) {
+	if err := resourceType.validate(); err != nil {
+		return Comment{}, err
+	}
+
+	var response struct {
+		Comment Comment `xml:"comment"`
+	}
+
+	if err := client.do(ctx, endpoint{
+		method: http.MethodPost,
+		path:   "/comment.xml",
+		form:   url.Values{"type": {string(resourceType)}, "id": {strconv.Itoa(id)}, "comment[body]": {body}},
+		signed: true,
+	}, &response); err != nil {
+		return Comment{}, err
+	}
+
+	return response.Comment, nil
+}
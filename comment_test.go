@@ -0,0 +1,201 @@
+package goodreads_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/BooleanCat/go-goodreads"
+	"github.com/BooleanCat/go-goodreads/internal/assert"
+	"github.com/BooleanCat/go-goodreads/internal/fakes"
+)
+
+func TestClient_CommentList(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewBufferString(commentListResponseBody)),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := signedClient(transport)
+
+	comments, err := client.CommentList(context.Background(), goodreads.ResourceUserStatus, 88)
+	assert.Nil(t, err)
+	assert.Equal(t, comments, goodreads.CommentList{
+		Start: 1,
+		End:   2,
+		Total: 2,
+		Comments: []goodreads.Comment{
+			{
+				ID:        5,
+				Body:      "Nice!",
+				CreatedAt: "2020-01-06T10:00:00-08:00",
+				UpdatedAt: "2020-01-06T10:00:00-08:00",
+				User:      goodreads.UserCompact{ID: 7, Name: "bcat"},
+			},
+			{ID: 6, Body: "Thanks."},
+		},
+	})
+
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+	request := transport.RoundTripArgsForCall(0)
+	assert.Equal(t, request.Method, http.MethodGet)
+	assert.Equal(t, request.URL.String(), "https://www.goodreads.com/comment/index.xml?id=88&key=key&type=user_status")
+	assertSignedForm(t, request, "")
+}
+
+func TestClient_CommentListPager(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewBufferString(commentListResponseBody)),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := signedClient(transport)
+	pager := client.CommentListPager(goodreads.ResourceReview, 77)
+
+	var ids []int
+	for pager.Next(context.Background()) {
+		ids = append(ids, pager.Item().(goodreads.Comment).ID)
+	}
+
+	assert.Nil(t, pager.Err())
+	assert.Equal(t, ids, []int{5, 6})
+	assert.EndsWith(t, transport.RoundTripArgsForCall(0).URL.String(), "id=77&key=key&page=1&type=review")
+}
+
+func TestClient_CommentList_InvalidResourceType(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	client := signedClient(transport)
+
+	_, err := client.CommentList(context.Background(), "book", 88)
+	assert.Equal(t, err, goodreads.ErrInvalidResourceType{ResourceType: "book"})
+	assert.ErrorMatches(t, err, `^invalid resource type: "book"$`)
+	assert.Equal(t, transport.RoundTripCallCount(), 0)
+}
+
+func TestClient_CommentCreate(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewBufferString(commentResponseBody)),
+		StatusCode: http.StatusCreated,
+	}, nil)
+
+	client := signedClient(transport)
+
+	comment, err := client.CommentCreate(context.Background(), goodreads.ResourceTopic, 3, "Nice!")
+	assert.Nil(t, err)
+	assert.Equal(t, comment, goodreads.Comment{ID: 5, Body: "Nice!", User: goodreads.UserCompact{ID: 7, Name: "bcat"}})
+
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+	request := transport.RoundTripArgsForCall(0)
+	assert.Equal(t, request.Method, http.MethodPost)
+	assert.Equal(t, request.URL.String(), "https://www.goodreads.com/comment.xml?key=key")
+	assertSignedForm(t, request, "comment%5Bbody%5D=Nice%21&id=3&type=topic")
+}
+
+func TestClient_CommentCreate_ResourceTypes(t *testing.T) {
+	for resourceType, name := range map[goodreads.ResourceType]string{
+		goodreads.ResourceAuthorBlogPost:        "author_blog_post",
+		goodreads.ResourceBlog:                  "blog",
+		goodreads.ResourceBookNewsPost:          "book_news_post",
+		goodreads.ResourceChapter:               "chapter",
+		goodreads.ResourceComment:               "comment",
+		goodreads.ResourceCommunityAnswer:       "community_answer",
+		goodreads.ResourceEventResponse:         "event_response",
+		goodreads.ResourceFanship:               "fanship",
+		goodreads.ResourceFriend:                "friend",
+		goodreads.ResourceGiveaway:              "giveaway",
+		goodreads.ResourceGiveawayRequest:       "giveaway_request",
+		goodreads.ResourceGroupUser:             "group_user",
+		goodreads.ResourceInterview:             "interview",
+		goodreads.ResourceLibrarianNote:         "librarian_note",
+		goodreads.ResourceLinkCollection:        "link_collection",
+		goodreads.ResourceList:                  "list",
+		goodreads.ResourceOwnedBook:             "owned_book",
+		goodreads.ResourcePhoto:                 "photo",
+		goodreads.ResourcePoll:                  "poll",
+		goodreads.ResourcePollVote:              "poll_vote",
+		goodreads.ResourceQueuedItem:            "queued_item",
+		goodreads.ResourceQuestion:              "question",
+		goodreads.ResourceQuestionUserStat:      "question_user_stat",
+		goodreads.ResourceQuiz:                  "quiz",
+		goodreads.ResourceQuizScore:             "quiz_score",
+		goodreads.ResourceRating:                "rating",
+		goodreads.ResourceReadStatus:            "read_status",
+		goodreads.ResourceRecommendation:        "recommendation",
+		goodreads.ResourceRecommendationRequest: "recommendation_request",
+		goodreads.ResourceReview:                "review",
+		goodreads.ResourceTopic:                 "topic",
+		goodreads.ResourceUser:                  "user",
+		goodreads.ResourceUserChallenge:         "user_challenge",
+		goodreads.ResourceUserFollowing:         "user_following",
+		goodreads.ResourceUserListChallenge:     "user_list_challenge",
+		goodreads.ResourceUserListVote:          "user_list_vote",
+		goodreads.ResourceUserQuote:             "user_quote",
+		goodreads.ResourceUserStatus:            "user_status",
+		goodreads.ResourceVideo:                 "video",
+	} {
+		transport := new(fakes.FakeRoundTripper)
+		transport.RoundTripReturns(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(commentResponseBody)),
+			StatusCode: http.StatusCreated,
+		}, nil)
+
+		client := signedClient(transport)
+
+		_, err := client.CommentCreate(context.Background(), resourceType, 3, "Nice!")
+		assert.Nil(t, err)
+
+		assert.Equal(t, transport.RoundTripCallCount(), 1)
+		assertSignedForm(t, transport.RoundTripArgsForCall(0), "comment%5Bbody%5D=Nice%21&id=3&type="+name)
+	}
+}
+
+func TestClient_CommentCreate_InvalidResourceType(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	client := signedClient(transport)
+
+	for _, resourceType := range []goodreads.ResourceType{"", "author_following", "quote"} {
+		_, err := client.CommentCreate(context.Background(), resourceType, 3, "Nice!")
+		assert.Equal(t, err, goodreads.ErrInvalidResourceType{ResourceType: resourceType})
+	}
+
+	assert.Equal(t, transport.RoundTripCallCount(), 0)
+}
+
+const commentResponseBody string = `
+	<GoodreadsResponse>
+		<comment>
+			<id>5</id>
+			<body>Nice!</body>
+			<user>
+				<id>7</id>
+				<name>bcat</name>
+			</user>
+		</comment>
+	</GoodreadsResponse>
+`
+
+const commentListResponseBody string = `
+	<GoodreadsResponse>
+		<comments start="1" end="2" total="2">
+			<comment>
+				<id>5</id>
+				<body>Nice!</body>
+				<created_at>2020-01-06T10:00:00-08:00</created_at>
+				<updated_at>2020-01-06T10:00:00-08:00</updated_at>
+				<user>
+					<id>7</id>
+					<name>bcat</name>
+				</user>
+			</comment>
+			<comment>
+				<id>6</id>
+				<body>Thanks.</body>
+			</comment>
+		</comments>
+	</GoodreadsResponse>
+`
@@ -0,0 +1,274 @@
+package goodreads
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/BooleanCat/go-goodreads/oauth"
+	"github.com/BooleanCat/go-goodreads/param"
+)
+
+// defaultResponseLimit is the largest response body, in bytes, that will be decoded unless an endpoint sets its own.
+const defaultResponseLimit = 16 << 20
+
+// An endpoint describes how to request a Goodreads API method and how to handle its response. Only path is required;
+// the zero value of every other field selects the default behaviour.
+type endpoint struct {
+	// method is the HTTP method of the request, GET by default.
+	method string
+
+	// path is appended to the client's URL.
+	path string
+
+	// params are applied to the request's query.
+	params []param.Param
+
+	// form, when set, is sent as the form-encoded request body.
+	form url.Values
+
+	// signed requests are signed with the client's OAuth access token.
+	signed bool
+
+	// signer, when set, signs the request in place of the client's OAuth access token.
+	signer *oauth.Signer
+
+	// status maps a response's status code to an error, or nil when the response should be decoded.
+	status func(code int) error
+
+	// limit is the largest response body, in bytes, that will be decoded.
+	limit int64
+
+	// decode decodes the response body into v.
+	decode func(r io.Reader, v interface{}) error
+
+	// decodeError maps an error from decode to the error returned to the caller.
+	decodeError func(err error) error
+}
+
+// do requests the endpoint and decodes the response into v. The response is discarded when v is nil.
+func (client Client) do(ctx context.Context, e endpoint, v interface{}) error {
+	request, err := client.newEndpointRequest(ctx, e)
+	if err != nil {
+		return err
+	}
+
+	response, err := client.getClient().Do(request)
+	if err != nil {
+		return fmt.Errorf("do request: %w", err)
+	}
+
+	defer closeIgnoreError(response.Body)
+
+	if err := e.getStatus()(response.StatusCode); err != nil {
+		return err
+	}
+
+	if v == nil {
+		return nil
+	}
+
+	if err := e.getDecode()(limitReader(response.Body, e.getLimit()), v); err != nil {
+		return e.getDecodeError()(err)
+	}
+
+	return nil
+}
+
+func (client Client) newEndpointRequest(ctx context.Context, e endpoint) (*http.Request, error) {
+	url := client.getURL() + e.path
+
+	signer := e.signer
+
+	if e.signed {
+		userSigner, err := client.userSigner()
+		if err != nil {
+			return nil, err
+		}
+
+		signer = &userSigner
+	}
+
+	if signer != nil {
+		return client.newSignedRequest(ctx, *signer, e.getMethod(), url, e.form, e.params...)
+	}
+
+	request, err := client.newRequestWithKey(ctx, e.getMethod(), url, e.form)
+	if err != nil {
+		return nil, err
+	}
+
+	return param.Apply(request, e.params...), nil
+}
+
+func (e endpoint) getMethod() string {
+	if e.method == "" {
+		return http.MethodGet
+	}
+
+	return e.method
+}
+
+func (e endpoint) getStatus() func(int) error {
+	if e.status == nil {
+		return defaultStatus
+	}
+
+	return e.status
+}
+
+func (e endpoint) getLimit() int64 {
+	if e.limit == 0 {
+		return defaultResponseLimit
+	}
+
+	return e.limit
+}
+
+func (e endpoint) getDecode() func(io.Reader, interface{}) error {
+	if e.decode == nil {
+		return decodeXML
+	}
+
+	return e.decode
+}
+
+func (e endpoint) getDecodeError() func(error) error {
+	if e.decodeError == nil {
+		return wrapDecodeError
+	}
+
+	return e.decodeError
+}
+
+func defaultStatus(code int) error {
+	switch code {
+	case http.StatusOK, http.StatusCreated:
+		return nil
+	case http.StatusUnauthorized:
+		return ErrUnauthorized{}
+	case http.StatusNotFound:
+		return ErrNotFound{}
+	default:
+		return ErrUnexpectedResponse{Code: code}
+	}
+}
+
+func wrapDecodeError(err error) error {
+	return fmt.Errorf("decode response: %w", err)
+}
+
+func decodeXML(r io.Reader, v interface{}) error {
+	return xml.NewDecoder(r).Decode(v)
+}
+
+func decodeJSON(r io.Reader, v interface{}) error {
+	return json.NewDecoder(r).Decode(v)
+}
+
+// decodeForm decodes a form-encoded body into v, which must be a *url.Values.
+func decodeForm(r io.Reader, v interface{}) error {
+	body, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+
+	values, err := url.ParseQuery(string(body))
+	if err != nil {
+		return err
+	}
+
+	*v.(*url.Values) = values
+
+	return nil
+}
+
+// decodeText reads a plain text body into v, which must be a *string.
+func decodeText(r io.Reader, v interface{}) error {
+	body, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+
+	*v.(*string) = strings.TrimSpace(string(body))
+
+	return nil
+}
+
+// parseIDs parses a comma separated list of IDs, where an empty ID is parsed as 0.
+func parseIDs(s string) ([]int, error) {
+	fields := strings.Split(s, ",")
+	ids := make([]int, len(fields))
+
+	for i, field := range fields {
+		if field = strings.TrimSpace(field); field == "" {
+			continue
+		}
+
+		id, err := strconv.Atoi(field)
+		if err != nil {
+			return nil, fmt.Errorf("decode response: %w", err)
+		}
+
+		ids[i] = id
+	}
+
+	return ids, nil
+}
+
+// joinIDs joins IDs with commas.
+func joinIDs(ids []int) string {
+	s := make([]string, len(ids))
+	for i, id := range ids {
+		s[i] = strconv.Itoa(id)
+	}
+
+	return strings.Join(s, ",")
+}
+
+// set sets a query parameter that an endpoint requires.
+func set(key, value string) param.Param {
+	return func(values url.Values) url.Values {
+		values.Set(key, value)
+
+		return values
+	}
+}
+
+// formatXML requests an XML response from endpoints that serve several formats.
+func formatXML(values url.Values) url.Values {
+	values.Set("format", "xml")
+
+	return values
+}
+
+var _ param.Param = formatXML
+
+type limitedReader struct {
+	reader io.Reader
+	limit  int64
+	read   int64
+}
+
+// limitReader returns a reader that fails with ErrResponseTooLarge once more than limit bytes have been read from r.
+func limitReader(r io.Reader, limit int64) io.Reader {
+	return &limitedReader{reader: io.LimitReader(r, limit+1), limit: limit}
+}
+
+func (r *limitedReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.read += int64(n)
+
+	if r.read > r.limit {
+		return n - int(r.read-r.limit), ErrResponseTooLarge{Limit: r.limit}
+	}
+
+	return n, err
+}
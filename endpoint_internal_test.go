@@ -0,0 +1,87 @@
+package goodreads
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/BooleanCat/go-goodreads/internal/assert"
+	"github.com/BooleanCat/go-goodreads/internal/fakes"
+)
+
+func TestClient_do_Status(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewBufferString(`<response><id>1</id></response>`)),
+		StatusCode: http.StatusTeapot,
+	}, nil)
+
+	client := Client{Client: &http.Client{Transport: transport}, Key: "key"}
+
+	var codes []int
+
+	var response struct {
+		ID int `xml:"id"`
+	}
+
+	err := client.do(context.Background(), endpoint{path: "/", status: func(code int) error {
+		codes = append(codes, code)
+
+		return nil
+	}}, &response)
+	assert.Nil(t, err)
+	assert.Equal(t, codes, []int{http.StatusTeapot})
+	assert.Equal(t, response.ID, 1)
+}
+
+func TestClient_do_StatusError(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(new(bytes.Buffer)),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := Client{Client: &http.Client{Transport: transport}, Key: "key"}
+
+	err := client.do(context.Background(), endpoint{path: "/", status: func(int) error {
+		return ErrNotFound{}
+	}}, new(struct{}))
+	assert.Equal(t, err, ErrNotFound{})
+}
+
+func TestClient_do_Limit(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewBufferString(`<response><id>1</id></response>`)),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := Client{Client: &http.Client{Transport: transport}, Key: "key"}
+
+	err := client.do(context.Background(), endpoint{path: "/", limit: 8}, new(struct{}))
+	assert.True(t, errors.As(err, new(ErrResponseTooLarge)))
+	assert.ErrorMatches(t, err, `^decode response: .*8 bytes`)
+}
+
+func TestClient_do_DecodeError(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewBufferString(`not xml`)),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := Client{Client: &http.Client{Transport: transport}, Key: "key"}
+
+	var decodeErrs []error
+
+	err := client.do(context.Background(), endpoint{path: "/", decodeError: func(err error) error {
+		decodeErrs = append(decodeErrs, err)
+
+		return ErrNotFound{}
+	}}, new(struct{}))
+	assert.Equal(t, err, ErrNotFound{})
+	assert.Equal(t, len(decodeErrs), 1)
+}
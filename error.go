@@ -16,6 +16,17 @@ func (err ErrUnexpectedResponse) Error() string {
 
 var _ error = ErrUnexpectedResponse{}
 
+// ErrResponseTooLarge is returned when an API call received a response body larger than it was willing to decode.
+type ErrResponseTooLarge struct {
+	Limit int64
+}
+
+func (err ErrResponseTooLarge) Error() string {
+	return fmt.Sprintf("response larger than %d bytes", err.Limit)
+}
+
+var _ error = ErrResponseTooLarge{}
+
 // ErrAPIKeyNotSet is returned when an API call was attempted without an API key set when required.
 type ErrAPIKeyNotSet struct{}
 
@@ -25,6 +36,107 @@ func (err ErrAPIKeyNotSet) Error() string {
 
 var _ error = ErrAPIKeyNotSet{}
 
+// ErrAPISecretNotSet is returned when an API call was attempted without an API secret set when required.
+type ErrAPISecretNotSet struct{}
+
+func (err ErrAPISecretNotSet) Error() string {
+	return "goodreads API secret not set"
+}
+
+var _ error = ErrAPISecretNotSet{}
+
+// ErrAccessTokenNotSet is returned when an API call acting on behalf of a user was attempted without an OAuth access
+// token set.
+type ErrAccessTokenNotSet struct{}
+
+func (err ErrAccessTokenNotSet) Error() string {
+	return "goodreads access token not set"
+}
+
+var _ error = ErrAccessTokenNotSet{}
+
+// ErrUnauthorized is returned when an API call was rejected because the client was not authorized to make it.
+type ErrUnauthorized struct{}
+
+func (err ErrUnauthorized) Error() string {
+	return "unauthorized"
+}
+
+var _ error = ErrUnauthorized{}
+
+// ErrMissingToken is returned when an OAuth token was expected in a response but none was given.
+type ErrMissingToken struct{}
+
+func (err ErrMissingToken) Error() string {
+	return "missing oauth token"
+}
+
+var _ error = ErrMissingToken{}
+
+// ErrInvalidISBN is returned when an ISBN is malformed or its check digit is wrong.
+type ErrInvalidISBN struct {
+	ISBN string
+}
+
+func (err ErrInvalidISBN) Error() string {
+	return fmt.Sprintf("invalid ISBN: %q", err.ISBN)
+}
+
+var _ error = ErrInvalidISBN{}
+
+// ErrInvalidRating is returned when a rating is not from 1 to 5 stars.
+type ErrInvalidRating struct {
+	Rating int
+}
+
+func (err ErrInvalidRating) Error() string {
+	return fmt.Sprintf("invalid rating: %d", err.Rating)
+}
+
+var _ error = ErrInvalidRating{}
+
+// ErrInvalidProgress is returned when reading progress is unset, negative or more than 100 percent.
+type ErrInvalidProgress struct {
+	Progress Progress
+}
+
+func (err ErrInvalidProgress) Error() string {
+	return fmt.Sprintf("invalid progress: %s", err.Progress)
+}
+
+var _ error = ErrInvalidProgress{}
+
+// ErrInvalidResourceType is returned when a resource type is not one that can be commented on.
+type ErrInvalidResourceType struct {
+	ResourceType ResourceType
+}
+
+func (err ErrInvalidResourceType) Error() string {
+	return fmt.Sprintf("invalid resource type: %q", string(err.ResourceType))
+}
+
+var _ error = ErrInvalidResourceType{}
+
+// ErrInvalidCondition is returned when a book condition is not one of the known BookConditions.
+type ErrInvalidCondition struct {
+	Condition BookCondition
+}
+
+func (err ErrInvalidCondition) Error() string {
+	return fmt.Sprintf("invalid condition: %d", err.Condition)
+}
+
+var _ error = ErrInvalidCondition{}
+
+// ErrEventLocationNotSet is returned when events are listed without a location.
+type ErrEventLocationNotSet struct{}
+
+func (err ErrEventLocationNotSet) Error() string {
+	return "event location not set"
+}
+
+var _ error = ErrEventLocationNotSet{}
+
 // ErrNotFound is returned when an API call could not find a requested resource.
 type ErrNotFound struct{}
 
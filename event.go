@@ -0,0 +1,68 @@
+package goodreads
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/BooleanCat/go-goodreads/param"
+)
+
+// An Event contains information about a literary event as defined by Goodreads.
+type Event struct {
+	ID          int     `xml:"id"`
+	Title       string  `xml:"title"`
+	Description string  `xml:"description"`
+	EventType   string  `xml:"event_type"`
+	Link        string  `xml:"link"`
+	ImageURL    string  `xml:"image_url"`
+	Venue       string  `xml:"venue"`
+	Address     string  `xml:"address"`
+	City        string  `xml:"city"`
+	StateCode   string  `xml:"state_code"`
+	PostalCode  string  `xml:"postal_code"`
+	CountryCode string  `xml:"country_code"`
+	Latitude    float64 `xml:"latitude"`
+	Longitude   float64 `xml:"longitude"`
+	StartAt     string  `xml:"start_at"`
+	EndAt       string  `xml:"end_at"`
+	UserID      int     `xml:"user_id"`
+	ResourceID  int     `xml:"resource_id"`
+}
+
+// An EventLocation is where to list events near. It is created by Coordinates or PostalCode.
+type EventLocation struct {
+	params []param.Param
+}
+
+// Coordinates returns the EventLocation of a latitude and longitude.
+func Coordinates(lat, lng float64) EventLocation {
+	return EventLocation{params: []param.Param{
+		set("lat", strconv.FormatFloat(lat, 'f', -1, 64)),
+		set("lng", strconv.FormatFloat(lng, 'f', -1, 64)),
+	}}
+}
+
+// PostalCode returns the EventLocation of a postal code. Optional parameter param.CountryCode may be given to
+// EventsList with it.
+func PostalCode(code string) EventLocation {
+	return EventLocation{params: []param.Param{set("search[postal_code]", code)}}
+}
+
+// EventsList returns the literary events near a location. Optional parameter param.CountryCode may be provided. An
+// ErrEventLocationNotSet is returned without making a request when location is the zero EventLocation.
+func (client Client) EventsList(ctx context.Context, location EventLocation, params ...param.Param) ([]Event, error) {
+	if len(location.params) == 0 {
+		return nil, ErrEventLocationNotSet{}
+	}
+
+	var response struct {
+		Events []Event `xml:"events>event"`
+	}
+
+	e := endpoint{path: "/event/index.xml", params: append(append([]param.Param{}, location.params...), params...)}
+	if err := client.do(ctx, e, &response); err != nil {
+		return nil, err
+	}
+
+	return response.Events, nil
+}
@@ -0,0 +1,119 @@
+package goodreads_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/BooleanCat/go-goodreads"
+	"github.com/BooleanCat/go-goodreads/internal/assert"
+	"github.com/BooleanCat/go-goodreads/internal/fakes"
+	"github.com/BooleanCat/go-goodreads/param"
+)
+
+func TestClient_EventsList(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewBufferString(eventsResponseBody)),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+
+	events, err := client.EventsList(context.Background(), goodreads.Coordinates(42.36, -71.0589))
+	assert.Nil(t, err)
+	assert.Equal(t, events, []goodreads.Event{
+		{
+			ID:          4,
+			Title:       "Author Reading",
+			Description: "A reading and signing.",
+			EventType:   "book signing",
+			Link:        "https://www.goodreads.com/event/show/4",
+			Venue:       "Public Library",
+			Address:     "700 Boylston St",
+			City:        "Boston",
+			StateCode:   "MA",
+			PostalCode:  "02116",
+			CountryCode: "US",
+			Latitude:    42.3495,
+			Longitude:   -71.0781,
+			StartAt:     "2020-01-06T18:00:00-05:00",
+			EndAt:       "2020-01-06T20:00:00-05:00",
+		},
+		{ID: 5, Title: "Book Fair"},
+	})
+
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+	request := transport.RoundTripArgsForCall(0)
+	assert.Equal(t, request.Method, http.MethodGet)
+	assert.Equal(t, request.URL.String(), "https://www.goodreads.com/event/index.xml?key=key&lat=42.36&lng=-71.0589")
+}
+
+func TestClient_EventsList_PostalCode(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewBufferString(eventsResponseBody)),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+
+	_, err := client.EventsList(context.Background(), goodreads.PostalCode("02116"), param.CountryCode("US"))
+	assert.Nil(t, err)
+
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+	assert.Equal(t, transport.RoundTripArgsForCall(0).URL.String(),
+		"https://www.goodreads.com/event/index.xml?key=key&search%5Bcountry_code%5D=US&search%5Bpostal_code%5D=02116")
+}
+
+func TestClient_EventsList_LocationNotSet(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+
+	_, err := client.EventsList(context.Background(), goodreads.EventLocation{}, param.CountryCode("US"))
+	assert.Equal(t, err, goodreads.ErrEventLocationNotSet{})
+	assert.ErrorMatches(t, err, `^event location not set$`)
+	assert.Equal(t, transport.RoundTripCallCount(), 0)
+}
+
+func TestClient_EventsList_UnexpectedResponse(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		StatusCode: http.StatusInternalServerError,
+	}, nil)
+
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+
+	_, err := client.EventsList(context.Background(), goodreads.PostalCode("02116"))
+	assert.Equal(t, err, goodreads.ErrUnexpectedResponse{Code: http.StatusInternalServerError})
+}
+
+const eventsResponseBody string = `
+	<GoodreadsResponse>
+		<events>
+			<event>
+				<id>4</id>
+				<title>Author Reading</title>
+				<description>A reading and signing.</description>
+				<event_type>book signing</event_type>
+				<link>https://www.goodreads.com/event/show/4</link>
+				<venue>Public Library</venue>
+				<address>700 Boylston St</address>
+				<city>Boston</city>
+				<state_code>MA</state_code>
+				<postal_code>02116</postal_code>
+				<country_code>US</country_code>
+				<latitude>42.3495</latitude>
+				<longitude>-71.0781</longitude>
+				<start_at>2020-01-06T18:00:00-05:00</start_at>
+				<end_at>2020-01-06T20:00:00-05:00</end_at>
+			</event>
+			<event>
+				<id>5</id>
+				<title>Book Fair</title>
+			</event>
+		</events>
+	</GoodreadsResponse>
+`
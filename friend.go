@@ -0,0 +1,112 @@
+package goodreads
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/BooleanCat/go-goodreads/param"
+)
+
+// A FriendRequest contains information about a friend request as defined by Goodreads.
+type FriendRequest struct {
+	ID        int         `xml:"id"`
+	CreatedAt string      `xml:"created_at"`
+	Message   string      `xml:"message"`
+	FromUser  UserCompact `xml:"from_user"`
+}
+
+// A FriendRequestList is a page of friend requests as defined by Goodreads.
+type FriendRequestList struct {
+	Start          int             `xml:"start,attr"`
+	End            int             `xml:"end,attr"`
+	Total          int             `xml:"total,attr"`
+	FriendRequests []FriendRequest `xml:"friend_request"`
+}
+
+func (list FriendRequestList) items() []interface{} {
+	items := make([]interface{}, len(list.FriendRequests))
+	for i, request := range list.FriendRequests {
+		items[i] = request
+	}
+
+	return items
+}
+
+// Friends returns a page of a user's friends given a Goodreads user ID. Optional parameter param.Page may be provided.
+func (client Client) Friends(ctx context.Context, userID int, params ...param.Param) (UserList, error) {
+	var response struct {
+		Friends UserList `xml:"friends"`
+	}
+
+	e := endpoint{
+		path:   fmt.Sprintf("/friend/user/%d", userID),
+		params: append([]param.Param{formatXML}, params...),
+		signed: true,
+	}
+	if err := client.do(ctx, e, &response); err != nil {
+		return UserList{}, err
+	}
+
+	return response.Friends, nil
+}
+
+// FriendsPager returns a Pager over every user of Friends. Each item is a UserCompact.
+func (client Client) FriendsPager(userID int, params ...param.Param) *Pager {
+	return NewPager(func(ctx context.Context, page int) ([]interface{}, int, int, error) {
+		users, err := client.Friends(ctx, userID, withPage(params, page)...)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+
+		return users.items(), users.End, users.Total, nil
+	})
+}
+
+// FriendRequests returns a page of the friend requests received by the user that authorized the client's access
+// token. Optional parameter param.Page may be provided.
+func (client Client) FriendRequests(ctx context.Context, params ...param.Param) (FriendRequestList, error) {
+	var response struct {
+		FriendRequests FriendRequestList `xml:"friend_requests"`
+	}
+
+	e := endpoint{path: "/friend/requests.xml", params: params, signed: true}
+	if err := client.do(ctx, e, &response); err != nil {
+		return FriendRequestList{}, err
+	}
+
+	return response.FriendRequests, nil
+}
+
+// FriendRequestsPager returns a Pager over every friend request of FriendRequests. Each item is a FriendRequest.
+func (client Client) FriendRequestsPager(params ...param.Param) *Pager {
+	return NewPager(func(ctx context.Context, page int) ([]interface{}, int, int, error) {
+		requests, err := client.FriendRequests(ctx, withPage(params, page)...)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+
+		return requests.items(), requests.End, requests.Total, nil
+	})
+}
+
+// ConfirmFriendRequest accepts a friend request given its Goodreads friend request ID.
+func (client Client) ConfirmFriendRequest(ctx context.Context, id int) error {
+	return client.answerFriendRequest(ctx, id, "Y")
+}
+
+// DeclineFriendRequest declines a friend request given its Goodreads friend request ID.
+func (client Client) DeclineFriendRequest(ctx context.Context, id int) error {
+	return client.answerFriendRequest(ctx, id, "N")
+}
+
+func (client Client) answerFriendRequest(ctx context.Context, id int, answer string) error {
+	return client.do(ctx, endpoint{
+		method: http.MethodPost,
+		path:   "/friend/confirm_request.xml",
+		form:   url.Values{"id": {strconv.Itoa(id)}, "response": {answer}},
+		signed: true,
+	}, nil)
+}
@@ -0,0 +1,186 @@
+package goodreads_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/BooleanCat/go-goodreads"
+	"github.com/BooleanCat/go-goodreads/internal/assert"
+	"github.com/BooleanCat/go-goodreads/internal/fakes"
+)
+
+func TestClient_Friends(t *testing.T) {
+	responseBody := bytes.NewBufferString(friendsResponseBody)
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(responseBody),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := signedClient(transport)
+
+	friends, err := client.Friends(context.Background(), 213)
+	assert.Nil(t, err)
+	assert.Equal(t, friends, goodreads.UserList{
+		Start: 1,
+		End:   2,
+		Total: 2,
+		Users: []goodreads.UserCompact{{ID: 7, Name: "bcat"}, {ID: 42, Name: "Baz Qux"}},
+	})
+
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+	request := transport.RoundTripArgsForCall(0)
+	assert.Equal(t, request.Method, http.MethodGet)
+	assert.Equal(t, request.URL.String(), "https://www.goodreads.com/friend/user/213?format=xml&key=key")
+	assertSignedForm(t, request, "")
+}
+
+func TestClient_Friends_Unauthorized(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		StatusCode: http.StatusUnauthorized,
+	}, nil)
+
+	client := signedClient(transport)
+
+	_, err := client.Friends(context.Background(), 213)
+	assert.Equal(t, err, goodreads.ErrUnauthorized{})
+}
+
+func TestClient_FriendsPager(t *testing.T) {
+	responseBody := bytes.NewBufferString(friendsResponseBody)
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(responseBody),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := signedClient(transport)
+	pager := client.FriendsPager(213)
+
+	var names []string
+	for pager.Next(context.Background()) {
+		names = append(names, pager.Item().(goodreads.UserCompact).Name)
+	}
+
+	assert.Nil(t, pager.Err())
+	assert.Equal(t, names, []string{"bcat", "Baz Qux"})
+	assert.EndsWith(t, transport.RoundTripArgsForCall(0).URL.String(), "format=xml&key=key&page=1")
+}
+
+func TestClient_FriendRequests(t *testing.T) {
+	responseBody := bytes.NewBufferString(friendRequestsResponseBody)
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(responseBody),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := signedClient(transport)
+
+	requests, err := client.FriendRequests(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, requests, goodreads.FriendRequestList{
+		Start: 1,
+		End:   1,
+		Total: 1,
+		FriendRequests: []goodreads.FriendRequest{{
+			ID:        55,
+			CreatedAt: "Sun Jan 05 10:00:00 -0800 2020",
+			Message:   "Hi!",
+			FromUser:  goodreads.UserCompact{ID: 7, Name: "bcat"},
+		}},
+	})
+
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+	request := transport.RoundTripArgsForCall(0)
+	assert.Equal(t, request.URL.String(), "https://www.goodreads.com/friend/requests.xml?key=key")
+	assertSignedForm(t, request, "")
+}
+
+func TestClient_FriendRequestsPager(t *testing.T) {
+	responseBody := bytes.NewBufferString(friendRequestsResponseBody)
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(responseBody),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := signedClient(transport)
+	pager := client.FriendRequestsPager()
+
+	var ids []int
+	for pager.Next(context.Background()) {
+		ids = append(ids, pager.Item().(goodreads.FriendRequest).ID)
+	}
+
+	assert.Nil(t, pager.Err())
+	assert.Equal(t, ids, []int{55})
+}
+
+func TestClient_ConfirmFriendRequest(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(new(bytes.Buffer)),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := signedClient(transport)
+
+	assert.Nil(t, client.ConfirmFriendRequest(context.Background(), 55))
+
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+	request := transport.RoundTripArgsForCall(0)
+	assert.Equal(t, request.Method, http.MethodPost)
+	assert.Equal(t, request.URL.String(), "https://www.goodreads.com/friend/confirm_request.xml?key=key")
+	assertSignedForm(t, request, "id=55&response=Y")
+}
+
+func TestClient_DeclineFriendRequest(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(new(bytes.Buffer)),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := signedClient(transport)
+
+	assert.Nil(t, client.DeclineFriendRequest(context.Background(), 55))
+
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+	assertSignedForm(t, transport.RoundTripArgsForCall(0), "id=55&response=N")
+}
+
+const friendsResponseBody string = `
+	<GoodreadsResponse>
+		<friends start="1" end="2" total="2">
+			<user>
+				<id>7</id>
+				<name>bcat</name>
+			</user>
+			<user>
+				<id>42</id>
+				<name>Baz Qux</name>
+			</user>
+		</friends>
+	</GoodreadsResponse>
+`
+
+const friendRequestsResponseBody string = `
+	<GoodreadsResponse>
+		<friend_requests start="1" end="1" total="1">
+			<friend_request>
+				<id>55</id>
+				<created_at>Sun Jan 05 10:00:00 -0800 2020</created_at>
+				<message>Hi!</message>
+				<from_user>
+					<id>7</id>
+					<name>bcat</name>
+				</from_user>
+			</friend_request>
+		</friend_requests>
+	</GoodreadsResponse>
+`
@@ -5,8 +5,11 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"os"
+	"strings"
 
+	"github.com/BooleanCat/go-goodreads/oauth"
 	"github.com/BooleanCat/go-goodreads/param"
 )
 
@@ -15,12 +18,23 @@ import (
 // and Secret to be set.
 //
 // If the GOODREADS_KEY environmental variable is set, it will be used in the
-// case of client.Key being an empty string.
+// case of client.Key being an empty string. Likewise for GOODREADS_SECRET and
+// client.Secret.
+//
+// Token is the OAuth access token used for API methods acting on behalf of a
+// user. See OAuthRequestToken for how to obtain one.
+//
+// API methods return ErrUnauthorized for a 401 Unauthorized response and
+// ErrNotFound for a 404 Not Found response. Any other unexpected status code
+// returns ErrUnexpectedResponse. Note that AuthorShow, BookShow and UserShow
+// used to return ErrUnexpectedResponse{Code: 401} for a 401 response; match
+// on ErrUnauthorized instead.
 type Client struct {
 	Client *http.Client
 	URL    string
 	Key    string
 	Secret string
+	Token  oauth.Credentials
 }
 
 func (client Client) String() string {
@@ -39,26 +53,87 @@ func (client Client) goodreadsKey() (string, error) {
 	return "", ErrAPIKeyNotSet{}
 }
 
+func (client Client) goodreadsSecret() (string, error) {
+	if client.Secret != "" {
+		return client.Secret, nil
+	}
+
+	if secret := os.Getenv("GOODREADS_SECRET"); secret != "" {
+		return secret, nil
+	}
+
+	return "", ErrAPISecretNotSet{}
+}
+
+func (client Client) signer(token oauth.Credentials) (oauth.Signer, error) {
+	key, err := client.goodreadsKey()
+	if err != nil {
+		return oauth.Signer{}, err
+	}
+
+	secret, err := client.goodreadsSecret()
+	if err != nil {
+		return oauth.Signer{}, err
+	}
+
+	return oauth.Signer{Consumer: oauth.Credentials{Token: key, Secret: secret}, Token: token}, nil
+}
+
 const defaultGoodreadsURL = "https://www.goodreads.com"
 
 func closeIgnoreError(c io.Closer) {
 	_ = c.Close()
 }
 
-func (client Client) newRequestWithKey(ctx context.Context, method, url string, body io.Reader) (*http.Request, error) {
+// newRequestWithKey creates a request with the API key set. The form, if not nil, is sent as a form-encoded body.
+func (client Client) newRequestWithKey(
+	ctx context.Context, method, url string, form url.Values,
+) (*http.Request, error) {
 	key, err := client.goodreadsKey()
 	if err != nil {
 		return nil, err
 	}
 
+	var body io.Reader
+	if form != nil {
+		body = strings.NewReader(form.Encode())
+	}
+
 	request, err := http.NewRequestWithContext(ctx, method, url, body)
 	if err != nil {
 		return nil, fmt.Errorf("create request: %w", err)
 	}
 
+	if form != nil {
+		request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+
 	return param.Apply(request, param.APIKey(key)), nil
 }
 
+func (client Client) userSigner() (oauth.Signer, error) {
+	if client.Token.Token == "" {
+		return oauth.Signer{}, ErrAccessTokenNotSet{}
+	}
+
+	return client.signer(client.Token)
+}
+
+func (client Client) newSignedRequest(
+	ctx context.Context, signer oauth.Signer, method, url string, form url.Values, params ...param.Param,
+) (*http.Request, error) {
+	request, err := client.newRequestWithKey(ctx, method, url, form)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := signer.Sign(param.Apply(request, params...), form); err != nil {
+		return nil, fmt.Errorf("sign request: %w", err)
+	}
+
+	return request, nil
+}
+
 func (client Client) getClient() *http.Client {
 	if client.Client == nil {
 		return http.DefaultClient
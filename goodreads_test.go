@@ -1,23 +1,31 @@
 package goodreads_test
 
 import (
+	"bytes"
+	"context"
 	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
 	"os"
 	"testing"
 	"time"
 
 	"github.com/BooleanCat/go-goodreads"
+	"github.com/BooleanCat/go-goodreads/httputils"
 	"github.com/BooleanCat/go-goodreads/internal/assert"
+	"github.com/BooleanCat/go-goodreads/internal/fakes"
+	"github.com/BooleanCat/go-goodreads/oauth"
 )
 
-var ticker *time.Ticker //nolint:gochecknoglobals
+var rateLimit *httputils.RateLimitTransport //nolint:gochecknoglobals
 
 func TestMain(m *testing.M) {
-	ticker = time.NewTicker(time.Second * 2)
+	rateLimit = httputils.RateLimit(http.DefaultTransport, time.Second*2, 1)
 
 	exitCode := m.Run()
 
-	ticker.Stop()
+	_ = rateLimit.Close()
 	os.Exit(exitCode)
 }
 
@@ -27,6 +35,81 @@ func TestClient_String(t *testing.T) {
 	assert.DoesNotContainSubstring(t, fmt.Sprint(client), "bar")
 }
 
+func TestClient_ResponseTooLarge(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(whitespace{}),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+
+	_, err := client.UserShow(context.Background(), 213)
+	assert.ErrorMatches(t, err, `^decode response: response larger than 16777216 bytes$`)
+}
+
+func TestClient_Unauthorized(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(new(bytes.Buffer)),
+		StatusCode: http.StatusUnauthorized,
+	}, nil)
+
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+
+	_, err := client.UserShow(context.Background(), 213)
+	assert.Equal(t, err, goodreads.ErrUnauthorized{})
+}
+
+// signedClient returns a client with an OAuth access token that sends requests through transport.
+func signedClient(transport http.RoundTripper) goodreads.Client {
+	return goodreads.Client{
+		Client: &http.Client{Transport: transport},
+		Key:    "key",
+		Secret: "secret",
+		Token:  oauth.Credentials{Token: "token", Secret: "token-secret"},
+	}
+}
+
+// assertSignedForm checks that request is signed with the access token of signedClient, with a signature covering
+// its query and form, and sends the given form.
+func assertSignedForm(t *testing.T, request *http.Request, form string) {
+	consumer := oauth.Credentials{Token: "key", Secret: "secret"}
+	token := oauth.Credentials{Token: "token", Secret: "token-secret"}
+
+	if form == "" {
+		assert.Nil(t, request.Body)
+
+		_, ok := verifySignature(request, consumer, token, nil)
+		assert.True(t, ok)
+
+		return
+	}
+
+	assert.Equal(t, request.Header.Get("Content-Type"), "application/x-www-form-urlencoded")
+
+	body, err := ioutil.ReadAll(request.Body)
+	assert.Nil(t, err)
+	assert.Equal(t, string(body), form)
+
+	values, err := url.ParseQuery(form)
+	assert.Nil(t, err)
+
+	_, ok := verifySignature(request, consumer, token, values)
+	assert.True(t, ok)
+}
+
+// whitespace is an endless reader of spaces.
+type whitespace struct{}
+
+func (whitespace) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = ' '
+	}
+
+	return len(p), nil
+}
+
 type fakeErr struct{}
 
 func (err fakeErr) Error() string {
@@ -0,0 +1,186 @@
+package goodreads
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/BooleanCat/go-goodreads/param"
+)
+
+// A Group contains information about a group as defined by Goodreads.
+type Group struct {
+	ID             int         `xml:"id"`
+	Title          string      `xml:"title"`
+	Access         string      `xml:"access"`
+	Location       string      `xml:"location"`
+	Category       string      `xml:"category"`
+	Subcategory    string      `xml:"subcategory"`
+	About          string      `xml:"group_about"`
+	Rules          string      `xml:"rules"`
+	Link           string      `xml:"link"`
+	ImageURL       string      `xml:"image_url"`
+	UsersCount     int         `xml:"users_count"`
+	LastActivityAt string      `xml:"last_activity_at"`
+	Folders        []Folder    `xml:"folders>folder"`
+	Moderators     []GroupUser `xml:"moderators>moderator"`
+}
+
+// A Folder contains information about a group's discussion folder as defined by Goodreads.
+type Folder struct {
+	ID            int    `xml:"id"`
+	GroupID       int    `xml:"group_id"`
+	Title         string `xml:"title"`
+	ItemsCount    int    `xml:"items_count"`
+	SubItemsCount int    `xml:"sub_count"`
+	UpdatedAt     string `xml:"updated_at"`
+}
+
+// A GroupUser contains information about a user's membership of a group as defined by Goodreads.
+type GroupUser struct {
+	Title         string      `xml:"title"`
+	FirstJoinedAt string      `xml:"first_joined_at"`
+	LastActiveAt  string      `xml:"last_active_at"`
+	CommentsCount int         `xml:"comments_count"`
+	User          UserCompact `xml:"user"`
+}
+
+// A GroupList is a page of groups as defined by Goodreads.
+type GroupList struct {
+	Start  int     `xml:"start,attr"`
+	End    int     `xml:"end,attr"`
+	Total  int     `xml:"total,attr"`
+	Groups []Group `xml:"group"`
+}
+
+func (list GroupList) items() []interface{} {
+	items := make([]interface{}, len(list.Groups))
+	for i, group := range list.Groups {
+		items[i] = group
+	}
+
+	return items
+}
+
+// A GroupUserList is a page of a group's members as defined by Goodreads.
+type GroupUserList struct {
+	Start int         `xml:"start,attr"`
+	End   int         `xml:"end,attr"`
+	Total int         `xml:"total,attr"`
+	Users []GroupUser `xml:"group_user"`
+}
+
+func (list GroupUserList) items() []interface{} {
+	items := make([]interface{}, len(list.Users))
+	for i, user := range list.Users {
+		items[i] = user
+	}
+
+	return items
+}
+
+// GroupSearch returns a page of the groups matching a query. Optional parameter param.Page may be provided.
+func (client Client) GroupSearch(ctx context.Context, query string, params ...param.Param) (GroupList, error) {
+	var response struct {
+		Groups GroupList `xml:"groups>list"`
+	}
+
+	e := endpoint{path: "/group/search.xml", params: append([]param.Param{param.Query(query)}, params...)}
+	if err := client.do(ctx, e, &response); err != nil {
+		return GroupList{}, err
+	}
+
+	return response.Groups, nil
+}
+
+// GroupSearchPager returns a Pager over every group of GroupSearch. Each item is a Group.
+func (client Client) GroupSearchPager(query string, params ...param.Param) *Pager {
+	return NewPager(func(ctx context.Context, page int) ([]interface{}, int, int, error) {
+		groups, err := client.GroupSearch(ctx, query, withPage(params, page)...)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+
+		return groups.items(), groups.End, groups.Total, nil
+	})
+}
+
+// GroupShow returns group information, including its folders and moderators, given a Goodreads group ID.
+func (client Client) GroupShow(ctx context.Context, id int) (Group, error) {
+	var response struct {
+		Group Group `xml:"group"`
+	}
+
+	if err := client.do(ctx, endpoint{path: fmt.Sprintf("/group/show/%d.xml", id)}, &response); err != nil {
+		return Group{}, err
+	}
+
+	return response.Group, nil
+}
+
+// GroupMembers returns a page of a group's members given a Goodreads group ID. Optional parameters param.Page and
+// param.Query may be provided.
+func (client Client) GroupMembers(ctx context.Context, groupID int, params ...param.Param) (GroupUserList, error) {
+	var response struct {
+		Users GroupUserList `xml:"group_users"`
+	}
+
+	e := endpoint{path: fmt.Sprintf("/group/members/%d.xml", groupID), params: params}
+	if err := client.do(ctx, e, &response); err != nil {
+		return GroupUserList{}, err
+	}
+
+	return response.Users, nil
+}
+
+// GroupMembersPager returns a Pager over every member of GroupMembers. Each item is a GroupUser.
+func (client Client) GroupMembersPager(groupID int, params ...param.Param) *Pager {
+	return NewPager(func(ctx context.Context, page int) ([]interface{}, int, int, error) {
+		users, err := client.GroupMembers(ctx, groupID, withPage(params, page)...)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+
+		return users.items(), users.End, users.Total, nil
+	})
+}
+
+// GroupJoin joins a group, given a Goodreads group ID, as the user that authorized the client's access token.
+func (client Client) GroupJoin(ctx context.Context, groupID int) error {
+	return client.do(ctx, endpoint{
+		method: http.MethodPost,
+		path:   "/group/join",
+		params: []param.Param{formatXML},
+		form:   url.Values{"id": {strconv.Itoa(groupID)}},
+		signed: true,
+	}, nil)
+}
+
+// GroupList returns a page of the groups a user is a member of given a Goodreads user ID. Optional parameter
+// param.Page may be provided.
+func (client Client) GroupList(ctx context.Context, userID int, params ...param.Param) (GroupList, error) {
+	var response struct {
+		Groups GroupList `xml:"groups>list"`
+	}
+
+	e := endpoint{path: fmt.Sprintf("/group/list/%d.xml", userID), params: params}
+	if err := client.do(ctx, e, &response); err != nil {
+		return GroupList{}, err
+	}
+
+	return response.Groups, nil
+}
+
+// GroupListPager returns a Pager over every group of GroupList. Each item is a Group.
+func (client Client) GroupListPager(userID int, params ...param.Param) *Pager {
+	return NewPager(func(ctx context.Context, page int) ([]interface{}, int, int, error) {
+		groups, err := client.GroupList(ctx, userID, withPage(params, page)...)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+
+		return groups.items(), groups.End, groups.Total, nil
+	})
+}
@@ -0,0 +1,305 @@
+package goodreads_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/BooleanCat/go-goodreads"
+	"github.com/BooleanCat/go-goodreads/internal/assert"
+	"github.com/BooleanCat/go-goodreads/internal/fakes"
+)
+
+func TestClient_GroupSearch(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewBufferString(groupListResponseBody)),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+
+	groups, err := client.GroupSearch(context.Background(), "sci fi")
+	assert.Nil(t, err)
+	assert.Equal(t, groups, groupListFixture())
+
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+	request := transport.RoundTripArgsForCall(0)
+	assert.Equal(t, request.Method, http.MethodGet)
+	assert.Equal(t, request.URL.String(), "https://www.goodreads.com/group/search.xml?key=key&q=sci+fi")
+}
+
+func TestClient_GroupSearchPager(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewBufferString(groupListResponseBody)),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+	pager := client.GroupSearchPager("sci fi")
+
+	var titles []string
+	for pager.Next(context.Background()) {
+		titles = append(titles, pager.Item().(goodreads.Group).Title)
+	}
+
+	assert.Nil(t, pager.Err())
+	assert.Equal(t, titles, []string{"Sci-Fi Club", "Space Opera"})
+	assert.EndsWith(t, transport.RoundTripArgsForCall(0).URL.String(), "key=key&page=1&q=sci+fi")
+}
+
+func TestClient_GroupShow(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewBufferString(groupResponseBody)),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+
+	group, err := client.GroupShow(context.Background(), 1)
+	assert.Nil(t, err)
+	assert.Equal(t, group, goodreads.Group{
+		ID:             1,
+		Title:          "Sci-Fi Club",
+		Access:         "public",
+		Location:       "Boston",
+		Category:       "Books & Literature",
+		Subcategory:    "Genres",
+		About:          "Reading science fiction.",
+		Rules:          "Be nice.",
+		Link:           "https://www.goodreads.com/group/show/1",
+		ImageURL:       "https://images.gr-assets.com/groups/1.jpg",
+		UsersCount:     1024,
+		LastActivityAt: "2020-01-06T10:00:00-08:00",
+		Folders: []goodreads.Folder{
+			{ID: 10, Title: "General", ItemsCount: 12, SubItemsCount: 340, UpdatedAt: "2020-01-06T10:00:00-08:00"},
+		},
+		Moderators: []goodreads.GroupUser{
+			{Title: "Moderator", User: goodreads.UserCompact{ID: 213, Name: "Foo Bar"}},
+		},
+	})
+
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+	assert.Equal(t, transport.RoundTripArgsForCall(0).URL.String(), "https://www.goodreads.com/group/show/1.xml?key=key")
+}
+
+func TestClient_GroupShow_NotFound(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		StatusCode: http.StatusNotFound,
+	}, nil)
+
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+
+	_, err := client.GroupShow(context.Background(), 1)
+	assert.True(t, goodreads.IsNotFound(err))
+}
+
+func TestClient_GroupMembers(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewBufferString(groupMembersResponseBody)),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+
+	members, err := client.GroupMembers(context.Background(), 1)
+	assert.Nil(t, err)
+	assert.Equal(t, members, goodreads.GroupUserList{
+		Start: 1,
+		End:   2,
+		Total: 2,
+		Users: []goodreads.GroupUser{
+			{
+				FirstJoinedAt: "2019-01-06T10:00:00-08:00",
+				LastActiveAt:  "2020-01-06T10:00:00-08:00",
+				CommentsCount: 7,
+				User:          goodreads.UserCompact{ID: 213, Name: "Foo Bar"},
+			},
+			{User: goodreads.UserCompact{ID: 7, Name: "bcat"}},
+		},
+	})
+
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+	request := transport.RoundTripArgsForCall(0)
+	assert.Equal(t, request.URL.String(), "https://www.goodreads.com/group/members/1.xml?key=key")
+}
+
+func TestClient_GroupMembersPager(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewBufferString(groupMembersResponseBody)),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+	pager := client.GroupMembersPager(1)
+
+	var names []string
+	for pager.Next(context.Background()) {
+		names = append(names, pager.Item().(goodreads.GroupUser).User.Name)
+	}
+
+	assert.Nil(t, pager.Err())
+	assert.Equal(t, names, []string{"Foo Bar", "bcat"})
+	assert.EndsWith(t, transport.RoundTripArgsForCall(0).URL.String(), "key=key&page=1")
+}
+
+func TestClient_GroupJoin(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(new(bytes.Buffer)),
+		StatusCode: http.StatusCreated,
+	}, nil)
+
+	client := signedClient(transport)
+
+	assert.Nil(t, client.GroupJoin(context.Background(), 1))
+
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+	request := transport.RoundTripArgsForCall(0)
+	assert.Equal(t, request.Method, http.MethodPost)
+	assert.Equal(t, request.URL.String(), "https://www.goodreads.com/group/join?format=xml&key=key")
+	assertSignedForm(t, request, "id=1")
+}
+
+func TestClient_GroupJoin_AccessTokenNotSet(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key", Secret: "secret"}
+
+	err := client.GroupJoin(context.Background(), 1)
+	assert.Equal(t, err, goodreads.ErrAccessTokenNotSet{})
+	assert.Equal(t, transport.RoundTripCallCount(), 0)
+}
+
+func TestClient_GroupList(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewBufferString(groupListResponseBody)),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+
+	groups, err := client.GroupList(context.Background(), 213)
+	assert.Nil(t, err)
+	assert.Equal(t, groups, groupListFixture())
+
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+	assert.Equal(t, transport.RoundTripArgsForCall(0).URL.String(), "https://www.goodreads.com/group/list/213.xml?key=key")
+}
+
+func TestClient_GroupListPager(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewBufferString(groupListResponseBody)),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+	pager := client.GroupListPager(213)
+
+	var ids []int
+	for pager.Next(context.Background()) {
+		ids = append(ids, pager.Item().(goodreads.Group).ID)
+	}
+
+	assert.Nil(t, pager.Err())
+	assert.Equal(t, ids, []int{1, 2})
+	assert.EndsWith(t, transport.RoundTripArgsForCall(0).URL.String(), "key=key&page=1")
+}
+
+const groupResponseBody string = `
+	<GoodreadsResponse>
+		<group>
+			<id>1</id>
+			<title>Sci-Fi Club</title>
+			<access>public</access>
+			<location>Boston</location>
+			<category>Books &amp; Literature</category>
+			<subcategory>Genres</subcategory>
+			<group_about>Reading science fiction.</group_about>
+			<rules>Be nice.</rules>
+			<link>https://www.goodreads.com/group/show/1</link>
+			<image_url>https://images.gr-assets.com/groups/1.jpg</image_url>
+			<users_count>1024</users_count>
+			<last_activity_at>2020-01-06T10:00:00-08:00</last_activity_at>
+			<folders>
+				<folder>
+					<id>10</id>
+					<title>General</title>
+					<items_count>12</items_count>
+					<sub_count>340</sub_count>
+					<updated_at>2020-01-06T10:00:00-08:00</updated_at>
+				</folder>
+			</folders>
+			<moderators>
+				<moderator>
+					<title>Moderator</title>
+					<user>
+						<id>213</id>
+						<name>Foo Bar</name>
+					</user>
+				</moderator>
+			</moderators>
+		</group>
+	</GoodreadsResponse>
+`
+
+const groupListResponseBody string = `
+	<GoodreadsResponse>
+		<groups>
+			<list start="1" end="2" total="2">
+				<group>
+					<id>1</id>
+					<title>Sci-Fi Club</title>
+					<users_count>1024</users_count>
+				</group>
+				<group>
+					<id>2</id>
+					<title>Space Opera</title>
+					<users_count>64</users_count>
+				</group>
+			</list>
+		</groups>
+	</GoodreadsResponse>
+`
+
+const groupMembersResponseBody string = `
+	<GoodreadsResponse>
+		<group_users start="1" end="2" total="2">
+			<group_user>
+				<first_joined_at>2019-01-06T10:00:00-08:00</first_joined_at>
+				<last_active_at>2020-01-06T10:00:00-08:00</last_active_at>
+				<comments_count>7</comments_count>
+				<user>
+					<id>213</id>
+					<name>Foo Bar</name>
+				</user>
+			</group_user>
+			<group_user>
+				<user>
+					<id>7</id>
+					<name>bcat</name>
+				</user>
+			</group_user>
+		</group_users>
+	</GoodreadsResponse>
+`
+
+func groupListFixture() goodreads.GroupList {
+	return goodreads.GroupList{
+		Start: 1,
+		End:   2,
+		Total: 2,
+		Groups: []goodreads.Group{
+			{ID: 1, Title: "Sci-Fi Club", UsersCount: 1024},
+			{ID: 2, Title: "Space Opera", UsersCount: 64},
+		},
+	}
+}
@@ -12,6 +12,8 @@ type DripLimitTransport struct {
 }
 
 // DripLimit creates a new DripLimitTransport. The caller must stop the time.Ticker.
+//
+// Deprecated: DripLimit waits for the ticker even when the request's context is done. Use RateLimit instead.
 func DripLimit(delegate http.RoundTripper, ticker *time.Ticker) DripLimitTransport {
 	return DripLimitTransport{
 		delegate: delegate,
@@ -0,0 +1,104 @@
+package httputils
+
+import (
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ErrTransportClosed is returned when a request is made through a RateLimitTransport that has been closed.
+type ErrTransportClosed struct{}
+
+func (err ErrTransportClosed) Error() string {
+	return "transport closed"
+}
+
+var _ error = ErrTransportClosed{}
+
+// RateLimitTransport rate limits requests with a token bucket. Each request takes a token, waiting for one if the
+// bucket is empty. It is safe for concurrent use.
+type RateLimitTransport struct {
+	delegate http.RoundTripper
+	tokens   chan struct{}
+	done     chan struct{}
+	once     sync.Once
+}
+
+// RateLimit creates a new RateLimitTransport that adds a token to the bucket every interval and holds at most burst
+// tokens, starting full. A burst of less than 1 is treated as 1, and an interval of 0 or less does not limit requests
+// at all. The caller must Close the transport.
+func RateLimit(delegate http.RoundTripper, interval time.Duration, burst int) *RateLimitTransport {
+	client := &RateLimitTransport{
+		delegate: delegate,
+		done:     make(chan struct{}),
+	}
+
+	if interval <= 0 {
+		return client
+	}
+
+	if burst < 1 {
+		burst = 1
+	}
+
+	client.tokens = make(chan struct{}, burst)
+
+	for i := 0; i < burst; i++ {
+		client.tokens <- struct{}{}
+	}
+
+	go client.refill(time.NewTicker(interval))
+
+	return client
+}
+
+func (client *RateLimitTransport) refill(ticker *time.Ticker) {
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-client.done:
+			return
+		case <-ticker.C:
+			select {
+			case client.tokens <- struct{}{}:
+			default:
+			}
+		}
+	}
+}
+
+// RoundTrip implements http.RoundTripper. It returns the request context's error if the context is done while
+// waiting for a token, and ErrTransportClosed if the transport is closed.
+func (client *RateLimitTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	select {
+	case <-client.done:
+		return nil, ErrTransportClosed{}
+	default:
+	}
+
+	if client.tokens == nil {
+		return client.delegate.RoundTrip(request)
+	}
+
+	select {
+	case <-client.done:
+		return nil, ErrTransportClosed{}
+	case <-request.Context().Done():
+		return nil, request.Context().Err()
+	case <-client.tokens:
+		return client.delegate.RoundTrip(request)
+	}
+}
+
+var _ http.RoundTripper = new(RateLimitTransport)
+
+// Close stops adding tokens to the bucket. Requests waiting for a token, and any made afterwards, fail with
+// ErrTransportClosed. Close may be called more than once.
+func (client *RateLimitTransport) Close() error {
+	client.once.Do(func() {
+		close(client.done)
+	})
+
+	return nil
+}
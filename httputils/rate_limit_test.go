@@ -0,0 +1,159 @@
+package httputils_test
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/BooleanCat/go-goodreads/httputils"
+	"github.com/BooleanCat/go-goodreads/internal/assert"
+	"github.com/BooleanCat/go-goodreads/internal/fakes"
+)
+
+func TestRateLimit(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+
+	client := httputils.RateLimit(transport, time.Millisecond, 1)
+	defer client.Close()
+
+	for i := 0; i < 3; i++ {
+		_, err := client.RoundTrip(newRequest(t, http.MethodGet, "")) //nolint:bodyclose
+		assert.Nil(t, err)
+	}
+
+	assert.Equal(t, transport.RoundTripCallCount(), 3)
+}
+
+func TestRateLimit_Burst(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+
+	client := httputils.RateLimit(transport, time.Hour, 3)
+	defer client.Close()
+
+	for i := 0; i < 3; i++ {
+		_, err := client.RoundTrip(newRequest(t, http.MethodGet, "")) //nolint:bodyclose
+		assert.Nil(t, err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err := client.RoundTrip(newRequest(t, http.MethodGet, "").WithContext(ctx)) //nolint:bodyclose
+	assert.Equal(t, err, context.DeadlineExceeded)
+	assert.Equal(t, transport.RoundTripCallCount(), 3)
+}
+
+func TestRateLimit_ContextCancelled(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+
+	client := httputils.RateLimit(transport, time.Hour, 1)
+	defer client.Close()
+
+	_, err := client.RoundTrip(newRequest(t, http.MethodGet, "")) //nolint:bodyclose
+	assert.Nil(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = client.RoundTrip(newRequest(t, http.MethodGet, "").WithContext(ctx)) //nolint:bodyclose
+	assert.Equal(t, err, context.Canceled)
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+}
+
+func TestRateLimit_DelegateDoFails(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(nil, fakeErr{})
+
+	client := httputils.RateLimit(transport, time.Millisecond, 1)
+	defer client.Close()
+
+	_, err := client.RoundTrip(newRequest(t, http.MethodGet, "")) //nolint:bodyclose
+	assert.ErrorMatches(t, err, `^oops$`)
+}
+
+func TestRateLimit_Close(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+
+	client := httputils.RateLimit(transport, time.Hour, 1)
+	assert.Nil(t, client.Close())
+	assert.Nil(t, client.Close())
+
+	_, err := client.RoundTrip(newRequest(t, http.MethodGet, "")) //nolint:bodyclose
+	assert.Equal(t, err, httputils.ErrTransportClosed{})
+	assert.ErrorMatches(t, err, `^transport closed$`)
+	assert.Equal(t, transport.RoundTripCallCount(), 0)
+}
+
+func TestRateLimit_CloseWhileWaiting(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+
+	client := httputils.RateLimit(transport, time.Hour, 1)
+
+	_, err := client.RoundTrip(newRequest(t, http.MethodGet, "")) //nolint:bodyclose
+	assert.Nil(t, err)
+
+	request := newRequest(t, http.MethodGet, "")
+	errs := make(chan error)
+
+	go func() {
+		_, err := client.RoundTrip(request) //nolint:bodyclose
+		errs <- err
+	}()
+
+	assert.Nil(t, client.Close())
+	assert.Equal(t, <-errs, httputils.ErrTransportClosed{})
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+}
+
+func TestRateLimit_Concurrent(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+
+	client := httputils.RateLimit(transport, time.Millisecond, 5)
+	defer client.Close()
+
+	var wg sync.WaitGroup
+
+	errs := make(chan error, 20)
+
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+
+		go func(request *http.Request) {
+			defer wg.Done()
+
+			_, err := client.RoundTrip(request) //nolint:bodyclose
+			errs <- err
+		}(newRequest(t, http.MethodGet, ""))
+	}
+
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		assert.Nil(t, err)
+	}
+
+	assert.Equal(t, transport.RoundTripCallCount(), 20)
+}
+
+func TestRateLimit_Unlimited(t *testing.T) {
+	for _, interval := range []time.Duration{0, -time.Second} {
+		transport := new(fakes.FakeRoundTripper)
+
+		client := httputils.RateLimit(transport, interval, 1)
+
+		for i := 0; i < 3; i++ {
+			_, err := client.RoundTrip(newRequest(t, http.MethodGet, "")) //nolint:bodyclose
+			assert.Nil(t, err)
+		}
+
+		assert.Equal(t, transport.RoundTripCallCount(), 3)
+
+		assert.Nil(t, client.Close())
+
+		_, err := client.RoundTrip(newRequest(t, http.MethodGet, "")) //nolint:bodyclose
+		assert.Equal(t, err, httputils.ErrTransportClosed{})
+	}
+}
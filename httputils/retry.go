@@ -0,0 +1,224 @@
+package httputils
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RetryPolicy configures a RetryTransport. Zero valued fields select the default.
+type RetryPolicy struct {
+	// MaxAttempts is the most times a request is sent, including the first attempt. The default is 3.
+	MaxAttempts int
+
+	// BaseDelay is the delay before the first retry, doubled for each retry after it. The default is 500ms.
+	BaseDelay time.Duration
+
+	// MaxDelay is the longest delay before a retry. A response asking to retry after longer than MaxDelay is returned
+	// without retrying. The default is 30s.
+	MaxDelay time.Duration
+}
+
+func (policy RetryPolicy) maxAttempts() int {
+	if policy.MaxAttempts == 0 {
+		return 3
+	}
+
+	return policy.MaxAttempts
+}
+
+func (policy RetryPolicy) baseDelay() time.Duration {
+	if policy.BaseDelay == 0 {
+		return 500 * time.Millisecond
+	}
+
+	return policy.BaseDelay
+}
+
+func (policy RetryPolicy) maxDelay() time.Duration {
+	if policy.MaxDelay == 0 {
+		return 30 * time.Second
+	}
+
+	return policy.MaxDelay
+}
+
+// backoff returns the delay before a retry, given the number of attempts so far, with up to half of it as jitter.
+func (policy RetryPolicy) backoff(attempts int) time.Duration {
+	delay := policy.maxDelay()
+	if base, shift := policy.baseDelay(), uint(attempts-1); base <= delay>>shift {
+		delay = base << shift
+	}
+
+	if half := int64(delay / 2); half > 0 {
+		return time.Duration(half + rand.Int63n(half)) //nolint:gosec
+	}
+
+	return delay
+}
+
+// RetryTransport retries idempotent requests that fail with a network error, a 429 Too Many Requests response or a
+// 5xx response. Retries back off exponentially with jitter unless the response has a Retry-After header.
+//
+// Requests signed with OAuth are never retried, since sending the same nonce and timestamp again may be rejected by
+// the server.
+type RetryTransport struct {
+	delegate http.RoundTripper
+	policy   RetryPolicy
+}
+
+// Retry creates a new RetryTransport.
+func Retry(delegate http.RoundTripper, policy RetryPolicy) RetryTransport {
+	return RetryTransport{
+		delegate: delegate,
+		policy:   policy,
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (client RetryTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	if !replayable(request) {
+		return client.delegate.RoundTrip(request)
+	}
+
+	for attempts := 1; ; attempts++ {
+		response, err := client.delegate.RoundTrip(request)
+		if attempts >= client.policy.maxAttempts() || request.Context().Err() != nil || !retryable(response, err) {
+			return response, err
+		}
+
+		delay, ok := client.delay(attempts, response)
+		if !ok {
+			return response, err
+		}
+
+		if response != nil {
+			drainAndClose(response.Body)
+		}
+
+		if err := sleep(request.Context(), delay); err != nil {
+			return nil, err
+		}
+
+		if request, err = rewind(request); err != nil {
+			return nil, err
+		}
+	}
+}
+
+var _ http.RoundTripper = RetryTransport{}
+
+// delay returns the delay before a retry, or false when the response asks for a longer delay than the policy allows.
+func (client RetryTransport) delay(attempts int, response *http.Response) (time.Duration, bool) {
+	if response == nil {
+		return client.policy.backoff(attempts), true
+	}
+
+	delay, ok := retryAfter(response.Header.Get("Retry-After"))
+	if !ok {
+		return client.policy.backoff(attempts), true
+	}
+
+	return delay, delay <= client.policy.maxDelay()
+}
+
+// replayable is true for requests that are idempotent, not signed with OAuth and whose body, if any, can be sent
+// again.
+func replayable(request *http.Request) bool {
+	if strings.HasPrefix(request.Header.Get("Authorization"), "OAuth ") {
+		return false
+	}
+
+	if request.Body != nil && request.Body != http.NoBody && request.GetBody == nil {
+		return false
+	}
+
+	switch request.Method {
+	case "", http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace, http.MethodPut, http.MethodDelete:
+		return true
+	}
+
+	_, hasKey := request.Header["Idempotency-Key"]
+	_, hasXKey := request.Header["X-Idempotency-Key"]
+
+	return hasKey || hasXKey
+}
+
+func retryable(response *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+
+	code := response.StatusCode
+
+	return code == http.StatusTooManyRequests || (code >= 500 && code != http.StatusNotImplemented)
+}
+
+// retryAfter parses a Retry-After header given as either seconds or an HTTP date.
+func retryAfter(header string) (time.Duration, bool) {
+	if header == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(header); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(header); err == nil {
+		if delay := time.Until(date); delay > 0 {
+			return delay, true
+		}
+
+		return 0, true
+	}
+
+	return 0, false
+}
+
+func sleep(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// rewind returns a copy of request with a fresh body to send again.
+func rewind(request *http.Request) (*http.Request, error) {
+	if request.Body == nil || request.Body == http.NoBody {
+		return request, nil
+	}
+
+	body, err := request.GetBody()
+	if err != nil {
+		return nil, fmt.Errorf("rewind request body: %w", err)
+	}
+
+	clone := request.Clone(request.Context())
+	clone.Body = body
+
+	return clone, nil
+}
+
+func drainAndClose(body io.ReadCloser) {
+	if body == nil {
+		return
+	}
+
+	_, _ = io.Copy(ioutil.Discard, io.LimitReader(body, 4<<10))
+	_ = body.Close()
+}
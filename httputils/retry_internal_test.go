@@ -0,0 +1,35 @@
+package httputils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/BooleanCat/go-goodreads/internal/assert"
+)
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	policy := RetryPolicy{BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second}
+
+	for attempts, limit := range map[int]time.Duration{
+		1: 100 * time.Millisecond,
+		2: 200 * time.Millisecond,
+		3: 400 * time.Millisecond,
+		4: 800 * time.Millisecond,
+		5: time.Second,
+		6: time.Second,
+	} {
+		delay := policy.backoff(attempts)
+		assert.True(t, delay >= limit/2)
+		assert.True(t, delay <= limit)
+	}
+}
+
+func TestRetryPolicy_BackoffLargeBaseDelay(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 100, BaseDelay: 1000 * time.Hour, MaxDelay: 4000 * time.Hour}
+
+	for attempts := 1; attempts <= 100; attempts++ {
+		delay := policy.backoff(attempts)
+		assert.True(t, delay >= 500*time.Hour)
+		assert.True(t, delay <= 4000*time.Hour)
+	}
+}
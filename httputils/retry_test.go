@@ -0,0 +1,244 @@
+package httputils_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/BooleanCat/go-goodreads/httputils"
+	"github.com/BooleanCat/go-goodreads/internal/assert"
+	"github.com/BooleanCat/go-goodreads/internal/fakes"
+	"github.com/BooleanCat/go-goodreads/oauth"
+)
+
+var fastRetry = httputils.RetryPolicy{BaseDelay: time.Millisecond, MaxDelay: 10 * time.Millisecond}
+
+func TestRetry(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturnsOnCall(0, responseWithStatus(http.StatusServiceUnavailable), nil)
+	transport.RoundTripReturnsOnCall(1, nil, fakeErr{})
+	transport.RoundTripReturnsOnCall(2, responseWithStatus(http.StatusOK), nil)
+
+	client := httputils.Retry(transport, fastRetry)
+
+	response, err := client.RoundTrip(newRequest(t, http.MethodGet, "")) //nolint:bodyclose
+	assert.Nil(t, err)
+	assert.Equal(t, response.StatusCode, http.StatusOK)
+	assert.Equal(t, transport.RoundTripCallCount(), 3)
+}
+
+func TestRetry_TooManyRequests(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturnsOnCall(0, responseWithStatus(http.StatusTooManyRequests), nil)
+	transport.RoundTripReturnsOnCall(1, responseWithStatus(http.StatusOK), nil)
+
+	client := httputils.Retry(transport, fastRetry)
+
+	response, err := client.RoundTrip(newRequest(t, http.MethodGet, "")) //nolint:bodyclose
+	assert.Nil(t, err)
+	assert.Equal(t, response.StatusCode, http.StatusOK)
+	assert.Equal(t, transport.RoundTripCallCount(), 2)
+}
+
+func TestRetry_MaxAttempts(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(responseWithStatus(http.StatusBadGateway), nil)
+
+	client := httputils.Retry(transport, httputils.RetryPolicy{MaxAttempts: 4, BaseDelay: time.Millisecond})
+
+	response, err := client.RoundTrip(newRequest(t, http.MethodGet, "")) //nolint:bodyclose
+	assert.Nil(t, err)
+	assert.Equal(t, response.StatusCode, http.StatusBadGateway)
+	assert.Equal(t, transport.RoundTripCallCount(), 4)
+}
+
+func TestRetry_MaxAttemptsNetworkError(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(nil, fakeErr{})
+
+	client := httputils.Retry(transport, fastRetry)
+
+	_, err := client.RoundTrip(newRequest(t, http.MethodGet, "")) //nolint:bodyclose
+	assert.ErrorMatches(t, err, `^oops$`)
+	assert.Equal(t, transport.RoundTripCallCount(), 3)
+}
+
+func TestRetry_NotRetryable(t *testing.T) {
+	for _, code := range []int{http.StatusOK, http.StatusNotFound, http.StatusNotImplemented} {
+		transport := new(fakes.FakeRoundTripper)
+		transport.RoundTripReturns(responseWithStatus(code), nil)
+
+		client := httputils.Retry(transport, fastRetry)
+
+		response, err := client.RoundTrip(newRequest(t, http.MethodGet, "")) //nolint:bodyclose
+		assert.Nil(t, err)
+		assert.Equal(t, response.StatusCode, code)
+		assert.Equal(t, transport.RoundTripCallCount(), 1)
+	}
+}
+
+func TestRetry_NotIdempotent(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(responseWithStatus(http.StatusServiceUnavailable), nil)
+
+	client := httputils.Retry(transport, fastRetry)
+
+	response, err := client.RoundTrip(newRequest(t, http.MethodPost, "a=b")) //nolint:bodyclose
+	assert.Nil(t, err)
+	assert.Equal(t, response.StatusCode, http.StatusServiceUnavailable)
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+}
+
+func TestRetry_IdempotencyKey(t *testing.T) {
+	var bodies []string
+
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripCalls(func(request *http.Request) (*http.Response, error) {
+		body, err := ioutil.ReadAll(request.Body)
+		assert.Nil(t, err)
+
+		bodies = append(bodies, string(body))
+		if len(bodies) == 1 {
+			return responseWithStatus(http.StatusServiceUnavailable), nil
+		}
+
+		return responseWithStatus(http.StatusCreated), nil
+	})
+
+	client := httputils.Retry(transport, fastRetry)
+
+	request := newRequest(t, http.MethodPost, "a=b")
+	request.Header.Set("Idempotency-Key", "123")
+
+	response, err := client.RoundTrip(request) //nolint:bodyclose
+	assert.Nil(t, err)
+	assert.Equal(t, response.StatusCode, http.StatusCreated)
+	assert.Equal(t, bodies, []string{"a=b", "a=b"})
+}
+
+func TestRetry_BodyNotReplayable(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(responseWithStatus(http.StatusServiceUnavailable), nil)
+
+	client := httputils.Retry(transport, fastRetry)
+
+	request := newRequest(t, http.MethodPut, "a=b")
+	request.GetBody = nil
+
+	_, err := client.RoundTrip(request) //nolint:bodyclose
+	assert.Nil(t, err)
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+}
+
+func TestRetry_RetryAfter(t *testing.T) {
+	rateLimited := responseWithStatus(http.StatusTooManyRequests)
+	rateLimited.Header.Set("Retry-After", "0")
+
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturnsOnCall(0, rateLimited, nil)
+	transport.RoundTripReturnsOnCall(1, responseWithStatus(http.StatusOK), nil)
+
+	client := httputils.Retry(transport, httputils.RetryPolicy{BaseDelay: time.Hour})
+
+	response, err := client.RoundTrip(newRequest(t, http.MethodGet, "")) //nolint:bodyclose
+	assert.Nil(t, err)
+	assert.Equal(t, response.StatusCode, http.StatusOK)
+	assert.Equal(t, transport.RoundTripCallCount(), 2)
+}
+
+func TestRetry_RetryAfterTooLong(t *testing.T) {
+	for _, retryAfter := range []string{"60", time.Now().Add(time.Minute).UTC().Format(http.TimeFormat)} {
+		rateLimited := responseWithStatus(http.StatusTooManyRequests)
+		rateLimited.Header.Set("Retry-After", retryAfter)
+
+		transport := new(fakes.FakeRoundTripper)
+		transport.RoundTripReturns(rateLimited, nil)
+
+		client := httputils.Retry(transport, fastRetry)
+
+		response, err := client.RoundTrip(newRequest(t, http.MethodGet, "")) //nolint:bodyclose
+		assert.Nil(t, err)
+		assert.Equal(t, response.StatusCode, http.StatusTooManyRequests)
+		assert.Equal(t, transport.RoundTripCallCount(), 1)
+	}
+}
+
+func TestRetry_ContextCancelled(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(responseWithStatus(http.StatusServiceUnavailable), nil)
+
+	client := httputils.Retry(transport, httputils.RetryPolicy{BaseDelay: time.Hour, MaxDelay: time.Hour})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err := client.RoundTrip(newRequest(t, http.MethodGet, "").WithContext(ctx)) //nolint:bodyclose
+	assert.Equal(t, err, context.DeadlineExceeded)
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+}
+
+func TestRetry_ContextCancelledBeforeRetry(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripCalls(func(*http.Request) (*http.Response, error) {
+		cancel()
+
+		return nil, context.Canceled
+	})
+
+	client := httputils.Retry(transport, fastRetry)
+
+	_, err := client.RoundTrip(newRequest(t, http.MethodGet, "").WithContext(ctx)) //nolint:bodyclose
+	assert.Equal(t, err, context.Canceled)
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+}
+
+func newRequest(t *testing.T, method, body string) *http.Request {
+	t.Helper()
+
+	var reader io.Reader
+	if body != "" {
+		reader = strings.NewReader(body)
+	}
+
+	request, err := http.NewRequest(method, "https://www.goodreads.com", reader) //nolint:noctx
+	assert.Nil(t, err)
+
+	return request
+}
+
+func responseWithStatus(code int) *http.Response {
+	return &http.Response{
+		StatusCode: code,
+		Header:     http.Header{},
+		Body:       ioutil.NopCloser(new(bytes.Buffer)),
+	}
+}
+
+func TestRetry_SignedRequest(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(responseWithStatus(http.StatusServiceUnavailable), nil)
+
+	client := httputils.Retry(transport, fastRetry)
+
+	for _, method := range []string{http.MethodGet, http.MethodPut, http.MethodDelete} {
+		request := newRequest(t, method, "")
+		signer := oauth.Signer{
+			Consumer: oauth.Credentials{Token: "key", Secret: "secret"},
+			Token:    oauth.Credentials{Token: "token", Secret: "token-secret"},
+		}
+		assert.Nil(t, signer.Sign(request, nil))
+
+		response, err := client.RoundTrip(request) //nolint:bodyclose
+		assert.Nil(t, err)
+		assert.Equal(t, response.StatusCode, http.StatusServiceUnavailable)
+	}
+
+	assert.Equal(t, transport.RoundTripCallCount(), 3)
+}
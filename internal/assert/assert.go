@@ -52,3 +52,10 @@ func True(t *testing.T, v interface{}) {
 		t.Fatal("expected true")
 	}
 }
+
+// False succeeds when the input is false.
+func False(t *testing.T, v interface{}) {
+	if !reflect.DeepEqual(v, false) {
+		t.Fatal("expected false")
+	}
+}
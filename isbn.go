@@ -0,0 +1,86 @@
+package goodreads
+
+import "strings"
+
+// NormalizeISBN validates an ISBN-10 or ISBN-13 and returns it as an ISBN-13 without hyphens or spaces. An
+// ErrInvalidISBN is returned when isbn is malformed or its check digit is wrong.
+func NormalizeISBN(isbn string) (string, error) {
+	digits := strings.ToUpper(strings.NewReplacer("-", "", " ", "").Replace(isbn))
+
+	switch {
+	case len(digits) == 10 && validISBN10(digits):
+		return isbn10To13(digits), nil
+	case len(digits) == 13 && validISBN13(digits):
+		return digits, nil
+	default:
+		return "", ErrInvalidISBN{ISBN: isbn}
+	}
+}
+
+func normalizeISBNs(isbns []string) ([]string, error) {
+	normalized := make([]string, len(isbns))
+
+	for i, isbn := range isbns {
+		n, err := NormalizeISBN(isbn)
+		if err != nil {
+			return nil, err
+		}
+
+		normalized[i] = n
+	}
+
+	return normalized, nil
+}
+
+func validISBN10(isbn string) bool {
+	sum := 0
+
+	for i, c := range isbn {
+		var digit int
+
+		switch {
+		case '0' <= c && c <= '9':
+			digit = int(c - '0')
+		case c == 'X' && i == 9:
+			digit = 10
+		default:
+			return false
+		}
+
+		sum += (10 - i) * digit
+	}
+
+	return sum%11 == 0
+}
+
+func validISBN13(isbn string) bool {
+	for _, c := range isbn {
+		if c < '0' || '9' < c {
+			return false
+		}
+	}
+
+	return isbn13CheckDigit(isbn[:12]) == isbn[12]
+}
+
+func isbn10To13(isbn string) string {
+	prefix := "978" + isbn[:9]
+
+	return prefix + string(isbn13CheckDigit(prefix))
+}
+
+// isbn13CheckDigit returns the check digit of the first 12 digits of an ISBN-13.
+func isbn13CheckDigit(digits string) byte {
+	sum := 0
+
+	for i := 0; i < 12; i++ {
+		weight := 1
+		if i%2 == 1 {
+			weight = 3
+		}
+
+		sum += weight * int(digits[i]-'0')
+	}
+
+	return byte('0' + (10-sum%10)%10)
+}
@@ -0,0 +1,41 @@
+package goodreads_test
+
+import (
+	"testing"
+
+	"github.com/BooleanCat/go-goodreads"
+	"github.com/BooleanCat/go-goodreads/internal/assert"
+)
+
+func TestNormalizeISBN_ISBN10(t *testing.T) {
+	isbn, err := goodreads.NormalizeISBN("0-306-40615-2")
+	assert.Nil(t, err)
+	assert.Equal(t, isbn, "9780306406157")
+}
+
+func TestNormalizeISBN_ISBN10CheckDigitX(t *testing.T) {
+	isbn, err := goodreads.NormalizeISBN("0-8044-2957-x")
+	assert.Nil(t, err)
+	assert.Equal(t, isbn, "9780804429573")
+}
+
+func TestNormalizeISBN_ISBN13(t *testing.T) {
+	isbn, err := goodreads.NormalizeISBN("978 0 306 40615 7")
+	assert.Nil(t, err)
+	assert.Equal(t, isbn, "9780306406157")
+}
+
+func TestNormalizeISBN_WrongCheckDigit(t *testing.T) {
+	_, err := goodreads.NormalizeISBN("978-0-306-40615-8")
+	assert.Equal(t, err, goodreads.ErrInvalidISBN{ISBN: "978-0-306-40615-8"})
+
+	_, err = goodreads.NormalizeISBN("0-306-40615-3")
+	assert.Equal(t, err, goodreads.ErrInvalidISBN{ISBN: "0-306-40615-3"})
+}
+
+func TestNormalizeISBN_Malformed(t *testing.T) {
+	for _, isbn := range []string{"", "030640615", "X306406152", "97803064061X7", "978030640615a"} {
+		_, err := goodreads.NormalizeISBN(isbn)
+		assert.ErrorMatches(t, err, `^invalid ISBN: `)
+	}
+}
@@ -0,0 +1,64 @@
+package goodreads
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/BooleanCat/go-goodreads/param"
+)
+
+// A List contains information about a Listopia list as defined by Goodreads.
+type List struct {
+	ID          int         `xml:"id"`
+	Title       string      `xml:"title"`
+	Description string      `xml:"description"`
+	BooksCount  int         `xml:"books_count"`
+	VotersCount int         `xml:"voters_count"`
+	CreatedAt   string      `xml:"created_at"`
+	User        UserCompact `xml:"user"`
+	Tags        []string    `xml:"tags>tag"`
+}
+
+// A ListList is a page of Listopia lists as defined by Goodreads.
+type ListList struct {
+	Start int    `xml:"start,attr"`
+	End   int    `xml:"end,attr"`
+	Total int    `xml:"total,attr"`
+	Lists []List `xml:"list"`
+}
+
+func (list ListList) items() []interface{} {
+	items := make([]interface{}, len(list.Lists))
+	for i, l := range list.Lists {
+		items[i] = l
+	}
+
+	return items
+}
+
+// ListsForBook returns a page of the Listopia lists a book is on given a Goodreads book ID. Optional parameter
+// param.Page may be provided.
+func (client Client) ListsForBook(ctx context.Context, bookID int, params ...param.Param) (ListList, error) {
+	var response struct {
+		Lists ListList `xml:"lists"`
+	}
+
+	e := endpoint{path: fmt.Sprintf("/list/book/%d.xml", bookID), params: params}
+	if err := client.do(ctx, e, &response); err != nil {
+		return ListList{}, err
+	}
+
+	return response.Lists, nil
+}
+
+// ListsForBookPager returns a Pager over every list of ListsForBook. Each item is a List.
+func (client Client) ListsForBookPager(bookID int, params ...param.Param) *Pager {
+	return NewPager(func(ctx context.Context, page int) ([]interface{}, int, int, error) {
+		lists, err := client.ListsForBook(ctx, bookID, withPage(params, page)...)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+
+		return lists.items(), lists.End, lists.Total, nil
+	})
+}
@@ -0,0 +1,107 @@
+package goodreads_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/BooleanCat/go-goodreads"
+	"github.com/BooleanCat/go-goodreads/internal/assert"
+	"github.com/BooleanCat/go-goodreads/internal/fakes"
+)
+
+func TestClient_ListsForBook(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewBufferString(listsResponseBody)),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+
+	lists, err := client.ListsForBook(context.Background(), 234225)
+	assert.Nil(t, err)
+	assert.Equal(t, lists, goodreads.ListList{
+		Start: 1,
+		End:   2,
+		Total: 2,
+		Lists: []goodreads.List{
+			{
+				ID:          1,
+				Title:       "Best Science Fiction",
+				Description: "The best science fiction novels.",
+				BooksCount:  7000,
+				VotersCount: 20000,
+				CreatedAt:   "2008-01-06T10:00:00-08:00",
+				User:        goodreads.UserCompact{ID: 213, Name: "Foo Bar"},
+				Tags:        []string{"science-fiction"},
+			},
+			{ID: 2, Title: "Desert Planets"},
+		},
+	})
+
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+	request := transport.RoundTripArgsForCall(0)
+	assert.Equal(t, request.Method, http.MethodGet)
+	assert.Equal(t, request.URL.String(), "https://www.goodreads.com/list/book/234225.xml?key=key")
+}
+
+func TestClient_ListsForBook_NotFound(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		StatusCode: http.StatusNotFound,
+	}, nil)
+
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+
+	_, err := client.ListsForBook(context.Background(), 234225)
+	assert.True(t, goodreads.IsNotFound(err))
+}
+
+func TestClient_ListsForBookPager(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewBufferString(listsResponseBody)),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+	pager := client.ListsForBookPager(234225)
+
+	var titles []string
+	for pager.Next(context.Background()) {
+		titles = append(titles, pager.Item().(goodreads.List).Title)
+	}
+
+	assert.Nil(t, pager.Err())
+	assert.Equal(t, titles, []string{"Best Science Fiction", "Desert Planets"})
+	assert.EndsWith(t, transport.RoundTripArgsForCall(0).URL.String(), "key=key&page=1")
+}
+
+const listsResponseBody string = `
+	<GoodreadsResponse>
+		<lists start="1" end="2" total="2">
+			<list>
+				<id>1</id>
+				<title>Best Science Fiction</title>
+				<description>The best science fiction novels.</description>
+				<books_count>7000</books_count>
+				<voters_count>20000</voters_count>
+				<created_at>2008-01-06T10:00:00-08:00</created_at>
+				<user>
+					<id>213</id>
+					<name>Foo Bar</name>
+				</user>
+				<tags>
+					<tag>science-fiction</tag>
+				</tags>
+			</list>
+			<list>
+				<id>2</id>
+				<title>Desert Planets</title>
+			</list>
+		</lists>
+	</GoodreadsResponse>
+`
@@ -0,0 +1,86 @@
+package goodreads
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/BooleanCat/go-goodreads/oauth"
+)
+
+// OAuthRequestToken obtains a request token, the first step of authorizing the client to act on behalf of a user. The
+// user must then visit the URL given by OAuthAuthorizeURL, after which the request token may be exchanged for an
+// access token with OAuthAccessToken.
+//
+// The callback is the URL the user is redirected to after authorizing and may be empty.
+func (client Client) OAuthRequestToken(ctx context.Context, callback string) (oauth.Credentials, error) {
+	signer, err := client.signer(oauth.Credentials{})
+	if err != nil {
+		return oauth.Credentials{}, err
+	}
+
+	signer.Callback = callback
+
+	return client.oauthToken(ctx, "/oauth/request_token", signer)
+}
+
+// OAuthAuthorizeURL returns the URL a user must visit to authorize a request token.
+func (client Client) OAuthAuthorizeURL(requestToken oauth.Credentials, callback string) string {
+	query := url.Values{"oauth_token": {requestToken.Token}}
+
+	if callback != "" {
+		query.Set("oauth_callback", callback)
+	}
+
+	return fmt.Sprintf("%s/oauth/authorize?%s", client.getURL(), query.Encode())
+}
+
+// OAuthAccessToken exchanges an authorized request token for an access token. The access token should be set as the
+// client's Token in order to call API methods that act on behalf of the user.
+//
+// The verifier is provided to the callback when the user authorized the request token and may be empty.
+func (client Client) OAuthAccessToken(
+	ctx context.Context, requestToken oauth.Credentials, verifier string,
+) (oauth.Credentials, error) {
+	signer, err := client.signer(requestToken)
+	if err != nil {
+		return oauth.Credentials{}, err
+	}
+
+	signer.Verifier = verifier
+
+	return client.oauthToken(ctx, "/oauth/access_token", signer)
+}
+
+func (client Client) oauthToken(ctx context.Context, path string, signer oauth.Signer) (oauth.Credentials, error) {
+	var values url.Values
+
+	e := endpoint{method: http.MethodPost, path: path, signer: &signer, decode: decodeForm}
+	if err := client.do(ctx, e, &values); err != nil {
+		return oauth.Credentials{}, err
+	}
+
+	if values.Get("oauth_token") == "" {
+		return oauth.Credentials{}, fmt.Errorf("decode response: %w", ErrMissingToken{})
+	}
+
+	return oauth.Credentials{Token: values.Get("oauth_token"), Secret: values.Get("oauth_token_secret")}, nil
+}
+
+// AuthUser returns the ID, name and link of the user that authorized the client's access token.
+func (client Client) AuthUser(ctx context.Context) (User, error) {
+	var response struct {
+		User struct {
+			ID   int    `xml:"id,attr"`
+			Name string `xml:"name"`
+			Link string `xml:"link"`
+		} `xml:"user"`
+	}
+
+	if err := client.do(ctx, endpoint{path: "/api/auth_user", signed: true}, &response); err != nil {
+		return User{}, err
+	}
+
+	return User{ID: response.User.ID, Name: response.User.Name, Link: response.User.Link}, nil
+}
@@ -0,0 +1,175 @@
+package oauth
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1" //nolint:gosec
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Credentials are an OAuth token, or consumer key, paired with its secret.
+type Credentials struct {
+	Token  string
+	Secret string
+}
+
+// Signer signs requests according to OAuth 1.0a using HMAC-SHA1.
+//
+// Nonce and Now may be left nil, in which case a random nonce and the current
+// time are used.
+type Signer struct {
+	Consumer Credentials
+	Token    Credentials
+	Callback string
+	Verifier string
+	Nonce    func() (string, error)
+	Now      func() time.Time
+}
+
+// Sign sets the Authorization header of request. The form values of a
+// form-encoded request body must be provided, as they are included in the
+// signature.
+func (signer Signer) Sign(request *http.Request, form url.Values) error {
+	nonce, err := signer.nonce()
+	if err != nil {
+		return fmt.Errorf("generate nonce: %w", err)
+	}
+
+	protocol := map[string]string{
+		"oauth_consumer_key":     signer.Consumer.Token,
+		"oauth_nonce":            nonce,
+		"oauth_signature_method": "HMAC-SHA1",
+		"oauth_timestamp":        strconv.FormatInt(signer.now().Unix(), 10),
+		"oauth_version":          "1.0",
+	}
+
+	if signer.Token.Token != "" {
+		protocol["oauth_token"] = signer.Token.Token
+	}
+
+	if signer.Callback != "" {
+		protocol["oauth_callback"] = signer.Callback
+	}
+
+	if signer.Verifier != "" {
+		protocol["oauth_verifier"] = signer.Verifier
+	}
+
+	protocol["oauth_signature"] = signer.signature(request, form, protocol)
+
+	request.Header.Set("Authorization", header(protocol))
+
+	return nil
+}
+
+func (signer Signer) signature(request *http.Request, form url.Values, protocol map[string]string) string {
+	pairs := make([][2]string, 0, len(protocol))
+
+	for key, value := range protocol {
+		pairs = append(pairs, [2]string{escape(key), escape(value)})
+	}
+
+	for _, values := range []url.Values{request.URL.Query(), form} {
+		for key, vs := range values {
+			for _, value := range vs {
+				pairs = append(pairs, [2]string{escape(key), escape(value)})
+			}
+		}
+	}
+
+	// Parameters are sorted by name and then by value, rather than as joined strings, since characters such as "-"
+	// sort before "=".
+	sort.Slice(pairs, func(i, j int) bool {
+		if pairs[i][0] != pairs[j][0] {
+			return pairs[i][0] < pairs[j][0]
+		}
+
+		return pairs[i][1] < pairs[j][1]
+	})
+
+	params := make([]string, len(pairs))
+	for i, pair := range pairs {
+		params[i] = pair[0] + "=" + pair[1]
+	}
+
+	base := strings.Join([]string{
+		strings.ToUpper(request.Method),
+		escape(baseURL(request.URL)),
+		escape(strings.Join(params, "&")),
+	}, "&")
+
+	mac := hmac.New(sha1.New, []byte(escape(signer.Consumer.Secret)+"&"+escape(signer.Token.Secret)))
+	_, _ = mac.Write([]byte(base))
+
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func (signer Signer) nonce() (string, error) {
+	if signer.Nonce != nil {
+		return signer.Nonce()
+	}
+
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
+
+func (signer Signer) now() time.Time {
+	if signer.Now != nil {
+		return signer.Now()
+	}
+
+	return time.Now()
+}
+
+func baseURL(u *url.URL) string {
+	scheme := strings.ToLower(u.Scheme)
+	host := strings.ToLower(u.Host)
+
+	if port := u.Port(); (scheme == "http" && port == "80") || (scheme == "https" && port == "443") {
+		host = strings.ToLower(u.Hostname())
+	}
+
+	return scheme + "://" + host + u.EscapedPath()
+}
+
+func header(protocol map[string]string) string {
+	params := make([]string, 0, len(protocol))
+
+	for key, value := range protocol {
+		params = append(params, fmt.Sprintf(`%s="%s"`, escape(key), escape(value)))
+	}
+
+	sort.Strings(params)
+
+	return "OAuth " + strings.Join(params, ", ")
+}
+
+// escape percent-encodes s as described by RFC 5849, section 3.6.
+func escape(s string) string {
+	var b strings.Builder
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9', c == '-', c == '.', c == '_', c == '~':
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+
+	return b.String()
+}
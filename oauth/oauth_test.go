@@ -0,0 +1,106 @@
+package oauth_test
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/BooleanCat/go-goodreads/internal/assert"
+	"github.com/BooleanCat/go-goodreads/oauth"
+)
+
+// The consumer, token, nonce, timestamp and expected signature are taken from the example in RFC 5849, section 1.2.
+const (
+	photosURL    = "http://photos.example.net/photos"
+	rfcSignature = `oauth_signature="tR3%2BTy81lMeYAr%2FFid0kMTYa%2FWM%3D"`
+)
+
+func rfcSigner() oauth.Signer {
+	return oauth.Signer{
+		Consumer: oauth.Credentials{Token: "dpf43f3p2l4k3l03", Secret: "kd94hf93k423kf44"},
+		Token:    oauth.Credentials{Token: "nnch734d00sl2jdk", Secret: "pfkkdhi9sl3r4s00"},
+		Nonce:    func() (string, error) { return "kllo9940pd9333jh", nil },
+		Now:      func() time.Time { return time.Unix(1191242096, 0) },
+	}
+}
+
+func TestSigner_Sign(t *testing.T) {
+	request, err := http.NewRequest(http.MethodGet, photosURL+"?file=vacation.jpg&size=original", nil)
+	assert.Nil(t, err)
+
+	assert.Nil(t, rfcSigner().Sign(request, nil))
+	assert.Equal(t, request.Header.Get("Authorization"), strings.Join([]string{
+		`OAuth oauth_consumer_key="dpf43f3p2l4k3l03"`,
+		`oauth_nonce="kllo9940pd9333jh"`,
+		rfcSignature,
+		`oauth_signature_method="HMAC-SHA1"`,
+		`oauth_timestamp="1191242096"`,
+		`oauth_token="nnch734d00sl2jdk"`,
+		`oauth_version="1.0"`,
+	}, ", "))
+}
+
+func TestSigner_Sign_DefaultPort(t *testing.T) {
+	rawURL := "HTTP://Photos.Example.NET:80/photos?size=original&file=vacation.jpg"
+
+	request, err := http.NewRequest(http.MethodGet, rawURL, nil)
+	assert.Nil(t, err)
+
+	assert.Nil(t, rfcSigner().Sign(request, nil))
+	assert.True(t, strings.Contains(request.Header.Get("Authorization"), rfcSignature))
+}
+
+func TestSigner_Sign_Form(t *testing.T) {
+	request, err := http.NewRequest(http.MethodPost, photosURL, nil)
+	assert.Nil(t, err)
+
+	form := url.Values{"file": {"vacation.jpg"}, "size": {"original"}}
+	assert.Nil(t, rfcSigner().Sign(request, form))
+	signature := `oauth_signature="wPkvxykrw%2BBTdCcGqKr%2B3I%2BPsiM%3D"`
+	assert.True(t, strings.Contains(request.Header.Get("Authorization"), signature))
+}
+
+// The expected signature was computed independently from the base string with parameters sorted by name and then by
+// value, as described in RFC 5849, section 3.4.1.3.2, where "a-b" sorts after "a".
+func TestSigner_Sign_SortsByNameThenValue(t *testing.T) {
+	request, err := http.NewRequest(http.MethodGet, photosURL+"?a=1&a-b=2&a=0", nil)
+	assert.Nil(t, err)
+
+	assert.Nil(t, rfcSigner().Sign(request, nil))
+	signature := `oauth_signature="a5jYFlFxcaw1aua9yFcJ7%2Bi%2BcCE%3D"`
+	assert.True(t, strings.Contains(request.Header.Get("Authorization"), signature))
+}
+
+func TestSigner_Sign_CallbackAndVerifier(t *testing.T) {
+	request, err := http.NewRequest(http.MethodPost, photosURL, nil)
+	assert.Nil(t, err)
+
+	signer := rfcSigner()
+	signer.Callback = "http://printer.example.com/ready"
+	signer.Verifier = "hfdp7dh39dks9884"
+
+	assert.Nil(t, signer.Sign(request, nil))
+	authorization := request.Header.Get("Authorization")
+	assert.True(t, strings.Contains(authorization, `oauth_callback="http%3A%2F%2Fprinter.example.com%2Fready"`))
+	assert.True(t, strings.Contains(authorization, `oauth_verifier="hfdp7dh39dks9884"`))
+}
+
+func TestSigner_Sign_NonceFails(t *testing.T) {
+	request, err := http.NewRequest(http.MethodGet, photosURL, nil)
+	assert.Nil(t, err)
+
+	signer := rfcSigner()
+	signer.Nonce = func() (string, error) { return "", fakeErr{} }
+
+	assert.ErrorMatches(t, signer.Sign(request, nil), `^generate nonce: oops$`)
+}
+
+type fakeErr struct{}
+
+func (err fakeErr) Error() string {
+	return "oops"
+}
+
+var _ error = fakeErr{}
@@ -0,0 +1,204 @@
+package goodreads_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/BooleanCat/go-goodreads"
+	"github.com/BooleanCat/go-goodreads/internal/assert"
+	"github.com/BooleanCat/go-goodreads/oauth"
+)
+
+func TestClient_OAuth(t *testing.T) {
+	server := httptest.NewServer(fakeOAuthServer())
+	defer server.Close()
+
+	client := goodreads.Client{URL: server.URL, Key: "key", Secret: "secret"}
+
+	requestToken, err := client.OAuthRequestToken(context.Background(), "https://foo.com/callback")
+	assert.Nil(t, err)
+	assert.Equal(t, requestToken, oauth.Credentials{Token: "request-token", Secret: "request-secret"})
+
+	authorizeURL := client.OAuthAuthorizeURL(requestToken, "https://foo.com/callback")
+	assert.Equal(t, authorizeURL, server.URL+
+		"/oauth/authorize?oauth_callback=https%3A%2F%2Ffoo.com%2Fcallback&oauth_token=request-token")
+
+	accessToken, err := client.OAuthAccessToken(context.Background(), requestToken, "verifier")
+	assert.Nil(t, err)
+	assert.Equal(t, accessToken, oauth.Credentials{Token: "access-token", Secret: "access-secret"})
+
+	client.Token = accessToken
+
+	user, err := client.AuthUser(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, user, goodreads.User{ID: 213, Name: "Foo Bar", Link: "https://foo.com/fbar"})
+}
+
+func TestClient_OAuthAuthorizeURL_NoCallback(t *testing.T) {
+	client := goodreads.Client{}
+
+	authorizeURL := client.OAuthAuthorizeURL(oauth.Credentials{Token: "foo", Secret: "bar"}, "")
+	assert.Equal(t, authorizeURL, "https://www.goodreads.com/oauth/authorize?oauth_token=foo")
+}
+
+func TestClient_OAuthRequestToken_SecretNotSet(t *testing.T) {
+	client := goodreads.Client{Key: "key"}
+
+	_, err := client.OAuthRequestToken(context.Background(), "")
+	assert.Equal(t, err, goodreads.ErrAPISecretNotSet{})
+}
+
+func TestClient_OAuthRequestToken_Unauthorized(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	client := goodreads.Client{URL: server.URL, Key: "key", Secret: "wrong"}
+
+	_, err := client.OAuthRequestToken(context.Background(), "")
+	assert.Equal(t, err, goodreads.ErrUnauthorized{})
+}
+
+func TestClient_OAuthAccessToken_BadSignature(t *testing.T) {
+	server := httptest.NewServer(fakeOAuthServer())
+	defer server.Close()
+
+	client := goodreads.Client{URL: server.URL, Key: "key", Secret: "secret"}
+
+	_, err := client.OAuthAccessToken(context.Background(), oauth.Credentials{Token: "request-token"}, "verifier")
+	assert.Equal(t, err, goodreads.ErrUnauthorized{})
+}
+
+func TestClient_OAuthAccessToken_MissingToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, "oauth_token_secret=foo")
+	}))
+	defer server.Close()
+
+	client := goodreads.Client{URL: server.URL, Key: "key", Secret: "secret"}
+
+	_, err := client.OAuthAccessToken(context.Background(), oauth.Credentials{Token: "foo"}, "")
+	assert.ErrorMatches(t, err, `^decode response: missing oauth token$`)
+}
+
+func TestClient_AuthUser_AccessTokenNotSet(t *testing.T) {
+	client := goodreads.Client{Key: "key", Secret: "secret"}
+
+	_, err := client.AuthUser(context.Background())
+	assert.Equal(t, err, goodreads.ErrAccessTokenNotSet{})
+}
+
+// fakeOAuthServer plays the Goodreads OAuth endpoints, responding with 401 Unauthorized to any request that is not
+// correctly signed.
+func fakeOAuthServer() http.Handler {
+	consumer := oauth.Credentials{Token: "key", Secret: "secret"}
+	requestToken := oauth.Credentials{Token: "request-token", Secret: "request-secret"}
+	accessToken := oauth.Credentials{Token: "access-token", Secret: "access-secret"}
+
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/oauth/request_token", func(w http.ResponseWriter, r *http.Request) {
+		params, ok := verifySignature(r, consumer, oauth.Credentials{}, nil)
+		if !ok || r.Method != http.MethodPost || params.Get("oauth_callback") != "https://foo.com/callback" {
+			w.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+
+		_, _ = fmt.Fprint(w, "oauth_token=request-token&oauth_token_secret=request-secret")
+	})
+
+	mux.HandleFunc("/oauth/access_token", func(w http.ResponseWriter, r *http.Request) {
+		params, ok := verifySignature(r, consumer, requestToken, nil)
+		if !ok || r.Method != http.MethodPost || params.Get("oauth_verifier") != "verifier" {
+			w.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+
+		_, _ = fmt.Fprint(w, "oauth_token=access-token&oauth_token_secret=access-secret")
+	})
+
+	mux.HandleFunc("/api/auth_user", func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := verifySignature(r, consumer, accessToken, nil); !ok || r.URL.Query().Get("key") != "key" {
+			w.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+
+		_, _ = fmt.Fprint(w, authUserResponseBody)
+	})
+
+	return mux
+}
+
+// verifySignature checks the request's OAuth signature, including any form sent in its body, by signing it again with
+// the same nonce and timestamp.
+func verifySignature(r *http.Request, consumer, token oauth.Credentials, form url.Values) (url.Values, bool) {
+	params := url.Values{}
+
+	for _, param := range strings.Split(strings.TrimPrefix(r.Header.Get("Authorization"), "OAuth "), ", ") {
+		kv := strings.SplitN(param, "=", 2)
+		if len(kv) != 2 {
+			return nil, false
+		}
+
+		value, err := url.QueryUnescape(strings.Trim(kv[1], `"`))
+		if err != nil {
+			return nil, false
+		}
+
+		params.Set(kv[0], value)
+	}
+
+	if params.Get("oauth_consumer_key") != consumer.Token || params.Get("oauth_token") != token.Token {
+		return nil, false
+	}
+
+	timestamp, err := strconv.ParseInt(params.Get("oauth_timestamp"), 10, 64)
+	if err != nil {
+		return nil, false
+	}
+
+	signer := oauth.Signer{
+		Consumer: consumer,
+		Token:    token,
+		Callback: params.Get("oauth_callback"),
+		Verifier: params.Get("oauth_verifier"),
+		Nonce:    func() (string, error) { return params.Get("oauth_nonce"), nil },
+		Now:      func() time.Time { return time.Unix(timestamp, 0) },
+	}
+
+	target := r.URL.String()
+	if !r.URL.IsAbs() {
+		target = "http://" + r.Host + r.URL.RequestURI()
+	}
+
+	request, err := http.NewRequest(r.Method, target, nil)
+	if err != nil {
+		return nil, false
+	}
+
+	if err := signer.Sign(request, form); err != nil {
+		return nil, false
+	}
+
+	return params, request.Header.Get("Authorization") == r.Header.Get("Authorization")
+}
+
+const authUserResponseBody string = `
+	<GoodreadsResponse>
+		<user id="213">
+			<name>Foo Bar</name>
+			<link><![CDATA[https://foo.com/fbar]]></link>
+		</user>
+	</GoodreadsResponse>
+`
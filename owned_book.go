@@ -0,0 +1,193 @@
+package goodreads
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/BooleanCat/go-goodreads/param"
+)
+
+// A BookCondition is the physical condition of an owned book.
+type BookCondition int
+
+// Conditions of an owned book.
+const (
+	ConditionBrandNew   BookCondition = 10
+	ConditionLikeNew    BookCondition = 20
+	ConditionVeryGood   BookCondition = 30
+	ConditionGood       BookCondition = 40
+	ConditionAcceptable BookCondition = 50
+	ConditionPoor       BookCondition = 60
+)
+
+func (condition BookCondition) validate() error {
+	switch condition {
+	case ConditionBrandNew, ConditionLikeNew, ConditionVeryGood, ConditionGood, ConditionAcceptable, ConditionPoor:
+		return nil
+	default:
+		return ErrInvalidCondition{Condition: condition}
+	}
+}
+
+// An OwnedBook contains information about a physical copy of a book owned by a user as defined by Goodreads.
+type OwnedBook struct {
+	ID                       int           `xml:"id"`
+	Book                     Book          `xml:"book"`
+	Condition                BookCondition `xml:"condition_code"`
+	ConditionDescription     string        `xml:"condition_description"`
+	OriginalPurchaseDate     string        `xml:"original_purchase_date"`
+	OriginalPurchaseLocation string        `xml:"original_purchase_location"`
+	UniqueCode               string        `xml:"unique_code"`
+	TradedCount              int           `xml:"traded_count"`
+	CurrentOwnerID           int           `xml:"current_owner_id"`
+	CurrentOwnerName         string        `xml:"current_owner_name"`
+	AvailableForSwap         bool          `xml:"available_for_swap"`
+}
+
+// An OwnedBookList is a page of a user's owned books as defined by Goodreads.
+type OwnedBookList struct {
+	Start      int         `xml:"start,attr"`
+	End        int         `xml:"end,attr"`
+	Total      int         `xml:"total,attr"`
+	OwnedBooks []OwnedBook `xml:"owned_book"`
+}
+
+func (list OwnedBookList) items() []interface{} {
+	items := make([]interface{}, len(list.OwnedBooks))
+	for i, ownedBook := range list.OwnedBooks {
+		items[i] = ownedBook
+	}
+
+	return items
+}
+
+// OwnedBookOptions are the fields that may be set when creating or updating an owned book. Zero valued fields are
+// left unset.
+type OwnedBookOptions struct {
+	// Condition is the physical condition of the copy.
+	Condition BookCondition
+
+	// ConditionDescription describes the condition of the copy.
+	ConditionDescription string
+
+	// PurchaseDate is the date the copy was first bought.
+	PurchaseDate time.Time
+
+	// PurchaseLocation is where the copy was first bought.
+	PurchaseLocation string
+
+	// UniqueCode identifies the copy, for example a BookCrossing ID.
+	UniqueCode string
+}
+
+func (options OwnedBookOptions) form() (url.Values, error) {
+	form := url.Values{}
+
+	if options.Condition != 0 {
+		if err := options.Condition.validate(); err != nil {
+			return nil, err
+		}
+
+		form.Set("owned_book[condition_code]", strconv.Itoa(int(options.Condition)))
+	}
+
+	if options.ConditionDescription != "" {
+		form.Set("owned_book[condition_description]", options.ConditionDescription)
+	}
+
+	if !options.PurchaseDate.IsZero() {
+		form.Set("owned_book[original_purchase_date]", options.PurchaseDate.Format("2006-01-02"))
+	}
+
+	if options.PurchaseLocation != "" {
+		form.Set("owned_book[original_purchase_location]", options.PurchaseLocation)
+	}
+
+	if options.UniqueCode != "" {
+		form.Set("owned_book[unique_code]", options.UniqueCode)
+	}
+
+	return form, nil
+}
+
+// OwnedBooksList returns a page of a user's owned books given a Goodreads user ID. Optional parameter param.Page may
+// be provided.
+func (client Client) OwnedBooksList(ctx context.Context, userID int, params ...param.Param) (OwnedBookList, error) {
+	var response struct {
+		OwnedBooks OwnedBookList `xml:"owned_books"`
+	}
+
+	e := endpoint{
+		path:   fmt.Sprintf("/owned_books/user/%d.xml", userID),
+		params: append([]param.Param{formatXML}, params...),
+		signed: true,
+	}
+	if err := client.do(ctx, e, &response); err != nil {
+		return OwnedBookList{}, err
+	}
+
+	return response.OwnedBooks, nil
+}
+
+// OwnedBooksListPager returns a Pager over every owned book of OwnedBooksList. Each item is an OwnedBook.
+func (client Client) OwnedBooksListPager(userID int, params ...param.Param) *Pager {
+	return NewPager(func(ctx context.Context, page int) ([]interface{}, int, int, error) {
+		ownedBooks, err := client.OwnedBooksList(ctx, userID, withPage(params, page)...)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+
+		return ownedBooks.items(), ownedBooks.End, ownedBooks.Total, nil
+	})
+}
+
+// OwnedBookCreate adds a copy of a book, given its Goodreads book ID, to the owned books of the user that authorized
+// the client's access token.
+func (client Client) OwnedBookCreate(ctx context.Context, bookID int, options OwnedBookOptions) (OwnedBook, error) {
+	form, err := options.form()
+	if err != nil {
+		return OwnedBook{}, err
+	}
+
+	form.Set("owned_book[book_id]", strconv.Itoa(bookID))
+
+	var response struct {
+		OwnedBook OwnedBook `xml:"owned_book"`
+	}
+
+	e := endpoint{method: http.MethodPost, path: "/owned_books.xml", form: form, signed: true}
+	if err := client.do(ctx, e, &response); err != nil {
+		return OwnedBook{}, err
+	}
+
+	return response.OwnedBook, nil
+}
+
+// OwnedBookUpdate updates an owned book given its Goodreads owned book ID.
+func (client Client) OwnedBookUpdate(ctx context.Context, id int, options OwnedBookOptions) error {
+	form, err := options.form()
+	if err != nil {
+		return err
+	}
+
+	return client.do(ctx, endpoint{
+		method: http.MethodPut,
+		path:   fmt.Sprintf("/owned_books/%d.xml", id),
+		form:   form,
+		signed: true,
+	}, nil)
+}
+
+// OwnedBookDestroy deletes an owned book given its Goodreads owned book ID.
+func (client Client) OwnedBookDestroy(ctx context.Context, id int) error {
+	return client.do(ctx, endpoint{
+		method: http.MethodPost,
+		path:   fmt.Sprintf("/owned_books/destroy/%d", id),
+		params: []param.Param{formatXML},
+		signed: true,
+	}, nil)
+}
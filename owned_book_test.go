@@ -0,0 +1,207 @@
+package goodreads_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/BooleanCat/go-goodreads"
+	"github.com/BooleanCat/go-goodreads/internal/assert"
+	"github.com/BooleanCat/go-goodreads/internal/fakes"
+)
+
+func TestClient_OwnedBooksList(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewBufferString(ownedBooksResponseBody)),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := signedClient(transport)
+
+	ownedBooks, err := client.OwnedBooksList(context.Background(), 213)
+	assert.Nil(t, err)
+	assert.Equal(t, ownedBooks, goodreads.OwnedBookList{
+		Start: 1,
+		End:   2,
+		Total: 2,
+		OwnedBooks: []goodreads.OwnedBook{
+			ownedBookFixture(),
+			{ID: 32, Book: goodreads.Book{ID: 456, Title: "qux"}, Condition: goodreads.ConditionPoor},
+		},
+	})
+
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+	request := transport.RoundTripArgsForCall(0)
+	assert.Equal(t, request.Method, http.MethodGet)
+	assert.Equal(t, request.URL.String(), "https://www.goodreads.com/owned_books/user/213.xml?format=xml&key=key")
+	assertSignedForm(t, request, "")
+}
+
+func TestClient_OwnedBooksListPager(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewBufferString(ownedBooksResponseBody)),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := signedClient(transport)
+	pager := client.OwnedBooksListPager(213)
+
+	var ids []int
+	for pager.Next(context.Background()) {
+		ids = append(ids, pager.Item().(goodreads.OwnedBook).ID)
+	}
+
+	assert.Nil(t, pager.Err())
+	assert.Equal(t, ids, []int{31, 32})
+	assert.EndsWith(t, transport.RoundTripArgsForCall(0).URL.String(), "format=xml&key=key&page=1")
+}
+
+func TestClient_OwnedBookCreate(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewBufferString(ownedBookResponseBody)),
+		StatusCode: http.StatusCreated,
+	}, nil)
+
+	client := signedClient(transport)
+
+	ownedBook, err := client.OwnedBookCreate(context.Background(), 123, goodreads.OwnedBookOptions{
+		Condition:        goodreads.ConditionLikeNew,
+		PurchaseDate:     time.Date(2019, time.March, 2, 0, 0, 0, 0, time.UTC),
+		PurchaseLocation: "Corner Bookshop",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, ownedBook, ownedBookFixture())
+
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+	request := transport.RoundTripArgsForCall(0)
+	assert.Equal(t, request.Method, http.MethodPost)
+	assert.Equal(t, request.URL.String(), "https://www.goodreads.com/owned_books.xml?key=key")
+	assertSignedForm(t, request, "owned_book%5Bbook_id%5D=123&owned_book%5Bcondition_code%5D=20"+
+		"&owned_book%5Boriginal_purchase_date%5D=2019-03-02&owned_book%5Boriginal_purchase_location%5D=Corner+Bookshop")
+}
+
+func TestClient_OwnedBookCreate_InvalidCondition(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	client := signedClient(transport)
+
+	_, err := client.OwnedBookCreate(context.Background(), 123, goodreads.OwnedBookOptions{Condition: 25})
+	assert.Equal(t, err, goodreads.ErrInvalidCondition{Condition: 25})
+	assert.ErrorMatches(t, err, `^invalid condition: 25$`)
+	assert.Equal(t, transport.RoundTripCallCount(), 0)
+}
+
+func TestClient_OwnedBookUpdate(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(new(bytes.Buffer)),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := signedClient(transport)
+
+	err := client.OwnedBookUpdate(context.Background(), 31, goodreads.OwnedBookOptions{
+		Condition:            goodreads.ConditionGood,
+		ConditionDescription: "Coffee stain on cover.",
+		UniqueCode:           "123-456",
+	})
+	assert.Nil(t, err)
+
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+	request := transport.RoundTripArgsForCall(0)
+	assert.Equal(t, request.Method, http.MethodPut)
+	assert.Equal(t, request.URL.String(), "https://www.goodreads.com/owned_books/31.xml?key=key")
+	assertSignedForm(t, request, "owned_book%5Bcondition_code%5D=40"+
+		"&owned_book%5Bcondition_description%5D=Coffee+stain+on+cover.&owned_book%5Bunique_code%5D=123-456")
+}
+
+func TestClient_OwnedBookUpdate_InvalidCondition(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	client := signedClient(transport)
+
+	err := client.OwnedBookUpdate(context.Background(), 31, goodreads.OwnedBookOptions{Condition: -1})
+	assert.Equal(t, err, goodreads.ErrInvalidCondition{Condition: -1})
+	assert.Equal(t, transport.RoundTripCallCount(), 0)
+}
+
+func TestClient_OwnedBookDestroy(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(new(bytes.Buffer)),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := signedClient(transport)
+
+	assert.Nil(t, client.OwnedBookDestroy(context.Background(), 31))
+
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+	request := transport.RoundTripArgsForCall(0)
+	assert.Equal(t, request.Method, http.MethodPost)
+	assert.Equal(t, request.URL.String(), "https://www.goodreads.com/owned_books/destroy/31?format=xml&key=key")
+	assertSignedForm(t, request, "")
+}
+
+const ownedBookResponseBody string = `
+	<GoodreadsResponse>
+		<owned_book>
+			<id>31</id>
+			<book>
+				<id>123</id>
+				<title>baz bar</title>
+			</book>
+			<condition_code>20</condition_code>
+			<original_purchase_date>2019-03-02</original_purchase_date>
+			<original_purchase_location>Corner Bookshop</original_purchase_location>
+			<current_owner_id>213</current_owner_id>
+			<current_owner_name>Foo Bar</current_owner_name>
+			<available_for_swap>true</available_for_swap>
+		</owned_book>
+	</GoodreadsResponse>
+`
+
+const ownedBooksResponseBody string = `
+	<GoodreadsResponse>
+		<owned_books start="1" end="2" total="2">
+			<owned_book>
+				<id>31</id>
+				<book>
+					<id>123</id>
+					<title>baz bar</title>
+				</book>
+				<condition_code>20</condition_code>
+				<original_purchase_date>2019-03-02</original_purchase_date>
+				<original_purchase_location>Corner Bookshop</original_purchase_location>
+				<current_owner_id>213</current_owner_id>
+				<current_owner_name>Foo Bar</current_owner_name>
+				<available_for_swap>true</available_for_swap>
+			</owned_book>
+			<owned_book>
+				<id>32</id>
+				<book>
+					<id>456</id>
+					<title>qux</title>
+				</book>
+				<condition_code>60</condition_code>
+			</owned_book>
+		</owned_books>
+	</GoodreadsResponse>
+`
+
+func ownedBookFixture() goodreads.OwnedBook {
+	return goodreads.OwnedBook{
+		ID:                       31,
+		Book:                     goodreads.Book{ID: 123, Title: "baz bar"},
+		Condition:                goodreads.ConditionLikeNew,
+		OriginalPurchaseDate:     "2019-03-02",
+		OriginalPurchaseLocation: "Corner Bookshop",
+		CurrentOwnerID:           213,
+		CurrentOwnerName:         "Foo Bar",
+		AvailableForSwap:         true,
+	}
+}
@@ -0,0 +1,77 @@
+package goodreads
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/BooleanCat/go-goodreads/param"
+)
+
+// A PageFunc fetches a page of a paginated listing given its page number, starting from 1. It returns the items on
+// the page along with the position of the last of them in the listing and the total number of items in the listing.
+type PageFunc func(ctx context.Context, page int) (items []interface{}, end, total int, err error)
+
+// A Pager iterates over every item of a paginated listing, lazily fetching pages as they are needed.
+type Pager struct {
+	fetch PageFunc
+	page  int
+	items []interface{}
+	item  interface{}
+	done  bool
+	err   error
+}
+
+// NewPager creates a Pager that fetches pages using fetch.
+func NewPager(fetch PageFunc) *Pager {
+	return &Pager{fetch: fetch}
+}
+
+// Next advances the Pager to the next item, fetching the next page when required. It returns false when there are no
+// more items or an error occurred, in which case Err returns the error.
+func (pager *Pager) Next(ctx context.Context) bool {
+	if pager.err != nil {
+		return false
+	}
+
+	if err := ctx.Err(); err != nil {
+		pager.err = err
+
+		return false
+	}
+
+	for len(pager.items) == 0 {
+		if pager.done {
+			return false
+		}
+
+		pager.page++
+
+		items, end, total, err := pager.fetch(ctx, pager.page)
+		if err != nil {
+			pager.err = fmt.Errorf("fetch page %d: %w", pager.page, err)
+
+			return false
+		}
+
+		pager.items = items
+		pager.done = len(items) == 0 || end >= total
+	}
+
+	pager.item, pager.items = pager.items[0], pager.items[1:]
+
+	return true
+}
+
+// Item returns the current item. Its type depends on the listing, as documented by the method that created the Pager.
+func (pager *Pager) Item() interface{} {
+	return pager.item
+}
+
+// Err returns the error, if any, that stopped iteration.
+func (pager *Pager) Err() error {
+	return pager.err
+}
+
+func withPage(params []param.Param, page int) []param.Param {
+	return append(append(make([]param.Param, 0, len(params)+1), params...), param.Page(page))
+}
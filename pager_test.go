@@ -0,0 +1,126 @@
+package goodreads_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/BooleanCat/go-goodreads"
+	"github.com/BooleanCat/go-goodreads/internal/assert"
+	"github.com/BooleanCat/go-goodreads/internal/fakes"
+	"github.com/BooleanCat/go-goodreads/param"
+)
+
+func TestPager(t *testing.T) {
+	var pages []int
+
+	pager := goodreads.NewPager(func(ctx context.Context, page int) ([]interface{}, int, int, error) {
+		pages = append(pages, page)
+
+		if page == 1 {
+			return []interface{}{"foo", "bar"}, 2, 3, nil
+		}
+
+		return []interface{}{"baz"}, 3, 3, nil
+	})
+
+	var items []interface{}
+	for pager.Next(context.Background()) {
+		items = append(items, pager.Item())
+	}
+
+	assert.Nil(t, pager.Err())
+	assert.Equal(t, items, []interface{}{"foo", "bar", "baz"})
+	assert.Equal(t, pages, []int{1, 2})
+}
+
+func TestPager_EmptyPage(t *testing.T) {
+	calls := 0
+
+	pager := goodreads.NewPager(func(ctx context.Context, page int) ([]interface{}, int, int, error) {
+		calls++
+
+		return nil, 0, 10, nil
+	})
+
+	assert.False(t, pager.Next(context.Background()))
+	assert.Nil(t, pager.Err())
+	assert.Equal(t, calls, 1)
+}
+
+func TestPager_FetchFails(t *testing.T) {
+	pager := goodreads.NewPager(func(ctx context.Context, page int) ([]interface{}, int, int, error) {
+		if page == 2 {
+			return nil, 0, 0, fakeErr{}
+		}
+
+		return []interface{}{"foo"}, 1, 2, nil
+	})
+
+	assert.True(t, pager.Next(context.Background()))
+	assert.False(t, pager.Next(context.Background()))
+	assert.ErrorMatches(t, pager.Err(), `^fetch page 2: oops$`)
+	assert.False(t, pager.Next(context.Background()))
+}
+
+func TestPager_ContextCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	pager := goodreads.NewPager(func(ctx context.Context, page int) ([]interface{}, int, int, error) {
+		return []interface{}{"foo", "bar"}, 2, 2, nil
+	})
+
+	assert.True(t, pager.Next(ctx))
+	cancel()
+	assert.False(t, pager.Next(ctx))
+	assert.Equal(t, pager.Err(), context.Canceled)
+}
+
+func TestClient_SearchBooksPager(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturnsOnCall(0, &http.Response{
+		Body:       ioutil.NopCloser(bytes.NewBufferString(searchPageResponseBody(1, 1, 2, "foo"))),
+		StatusCode: http.StatusOK,
+	}, nil)
+	transport.RoundTripReturnsOnCall(1, &http.Response{
+		Body:       ioutil.NopCloser(bytes.NewBufferString(searchPageResponseBody(2, 2, 2, "bar"))),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+	pager := client.SearchBooksPager("baz", param.PerPage(1))
+
+	var titles []string
+	for pager.Next(context.Background()) {
+		titles = append(titles, pager.Item().(goodreads.Work).BestBook.Title)
+	}
+
+	assert.Nil(t, pager.Err())
+	assert.Equal(t, titles, []string{"foo", "bar"})
+
+	assert.Equal(t, transport.RoundTripCallCount(), 2)
+	assert.EndsWith(t, transport.RoundTripArgsForCall(0).URL.String(), "key=key&page=1&per_page=1&q=baz")
+	assert.EndsWith(t, transport.RoundTripArgsForCall(1).URL.String(), "key=key&page=2&per_page=1&q=baz")
+}
+
+func searchPageResponseBody(start, end, total int, title string) string {
+	return fmt.Sprintf(`
+		<GoodreadsResponse>
+			<search>
+				<results-start>%d</results-start>
+				<results-end>%d</results-end>
+				<total-results>%d</total-results>
+				<results>
+					<work>
+						<best_book>
+							<title>%s</title>
+						</best_book>
+					</work>
+				</results>
+			</search>
+		</GoodreadsResponse>
+	`, start, end, total, title)
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"strconv"
+	"strings"
 )
 
 // Param is a mutation of a URL's values.
@@ -52,3 +54,245 @@ func Rating(r float32) func(url.Values) url.Values {
 }
 
 var _ Param = Rating(0)
+
+// Query sets the query of a search.
+func Query(q string) Param {
+	return func(values url.Values) url.Values {
+		values.Set("q", q)
+
+		return values
+	}
+}
+
+var _ Param = Query("")
+
+// ISBN sets the ISBNs to look up, separated by commas.
+func ISBN(isbns ...string) Param {
+	return func(values url.Values) url.Values {
+		values.Set("isbn", strings.Join(isbns, ","))
+
+		return values
+	}
+}
+
+var _ Param = ISBN()
+
+// ISBNs sets the ISBNs to look up in bulk, separated by commas.
+func ISBNs(isbns ...string) Param {
+	return func(values url.Values) url.Values {
+		values.Set("isbns", strings.Join(isbns, ","))
+
+		return values
+	}
+}
+
+var _ Param = ISBNs()
+
+// Page selects which page of results to return, starting from 1.
+func Page(n int) Param {
+	return func(values url.Values) url.Values {
+		values.Set("page", strconv.Itoa(n))
+
+		return values
+	}
+}
+
+var _ Param = Page(0)
+
+// PerPage sets how many results are returned per page.
+func PerPage(n int) Param {
+	return func(values url.Values) url.Values {
+		values.Set("per_page", strconv.Itoa(n))
+
+		return values
+	}
+}
+
+var _ Param = PerPage(0)
+
+// A Field is a field of a book that a search query may be matched against.
+type Field string
+
+// Fields that a search query may be matched against.
+const (
+	FieldTitle  Field = "title"
+	FieldAuthor Field = "author"
+	FieldAll    Field = "all"
+)
+
+// SearchField restricts a search query to match against the given field.
+func SearchField(field Field) Param {
+	return func(values url.Values) url.Values {
+		values.Set("search[field]", string(field))
+
+		return values
+	}
+}
+
+var _ Param = SearchField(FieldAll)
+
+// Shelf restricts results to books on the named shelf.
+func Shelf(name string) Param {
+	return func(values url.Values) url.Values {
+		values.Set("shelf", name)
+
+		return values
+	}
+}
+
+var _ Param = Shelf("")
+
+// Author restricts results to books by the named author.
+func Author(name string) Param {
+	return func(values url.Values) url.Values {
+		values.Set("author", name)
+
+		return values
+	}
+}
+
+var _ Param = Author("")
+
+// A SortField is a field that reviews may be sorted by.
+type SortField string
+
+// Fields that reviews may be sorted by.
+const (
+	SortTitle            SortField = "title"
+	SortAuthor           SortField = "author"
+	SortCover            SortField = "cover"
+	SortRating           SortField = "rating"
+	SortYearPub          SortField = "year_pub"
+	SortDatePub          SortField = "date_pub"
+	SortDatePubEdition   SortField = "date_pub_edition"
+	SortDateStarted      SortField = "date_started"
+	SortDateRead         SortField = "date_read"
+	SortDateUpdated      SortField = "date_updated"
+	SortDateAdded        SortField = "date_added"
+	SortRecommender      SortField = "recommender"
+	SortAvgRating        SortField = "avg_rating"
+	SortNumPages         SortField = "num_pages"
+	SortReview           SortField = "review"
+	SortReadCount        SortField = "read_count"
+	SortVotes            SortField = "votes"
+	SortRandom           SortField = "random"
+	SortComments         SortField = "comments"
+	SortNotes            SortField = "notes"
+	SortISBN             SortField = "isbn"
+	SortISBN13           SortField = "isbn13"
+	SortASIN             SortField = "asin"
+	SortNumRatings       SortField = "num_ratings"
+	SortOwned            SortField = "owned"
+	SortPosition         SortField = "position"
+	SortShelves          SortField = "shelves"
+	SortFormat           SortField = "format"
+	SortDatePurchased    SortField = "date_purchased"
+	SortPurchaseLocation SortField = "purchase_location"
+	SortCondition        SortField = "condition"
+)
+
+// Sort sorts results by the given field.
+func Sort(field SortField) Param {
+	return func(values url.Values) url.Values {
+		values.Set("sort", string(field))
+
+		return values
+	}
+}
+
+var _ Param = Sort(SortTitle)
+
+// A Direction is the direction in which results are sorted.
+type Direction string
+
+// Directions in which results may be sorted.
+const (
+	Ascending  Direction = "a"
+	Descending Direction = "d"
+)
+
+// Order sorts results in the given direction.
+func Order(direction Direction) Param {
+	return func(values url.Values) url.Values {
+		values.Set("order", string(direction))
+
+		return values
+	}
+}
+
+var _ Param = Order(Ascending)
+
+// SearchQuery restricts results to those matching the query.
+func SearchQuery(q string) Param {
+	return func(values url.Values) url.Values {
+		values.Set("search[query]", q)
+
+		return values
+	}
+}
+
+var _ Param = SearchQuery("")
+
+// An UpdateType is a type of update in a user's updates feed.
+type UpdateType string
+
+// Types of update in a user's updates feed.
+const (
+	UpdateBooks    UpdateType = "books"
+	UpdateReviews  UpdateType = "reviews"
+	UpdateStatuses UpdateType = "statuses"
+)
+
+// Updates restricts an updates feed to the given type of update.
+func Updates(updateType UpdateType) Param {
+	return func(values url.Values) url.Values {
+		values.Set("update", string(updateType))
+
+		return values
+	}
+}
+
+var _ Param = Updates(UpdateBooks)
+
+// An UpdateSource is a group of users whose updates may be shown in an updates feed.
+type UpdateSource string
+
+// Groups of users whose updates may be shown in an updates feed.
+const (
+	UpdatesFromFriends    UpdateSource = "friends"
+	UpdatesFromFollowing  UpdateSource = "following"
+	UpdatesFromTopFriends UpdateSource = "top_friends"
+)
+
+// UpdateFilter restricts an updates feed to updates from the given group of users.
+func UpdateFilter(source UpdateSource) Param {
+	return func(values url.Values) url.Values {
+		values.Set("update_filter", string(source))
+
+		return values
+	}
+}
+
+var _ Param = UpdateFilter(UpdatesFromFriends)
+
+// MaxUpdates limits how many updates are returned.
+func MaxUpdates(n int) Param {
+	return func(values url.Values) url.Values {
+		values.Set("max_updates", strconv.Itoa(n))
+
+		return values
+	}
+}
+
+var _ Param = MaxUpdates(0)
+
+// CountryCode restricts results to those in a country given its two letter code.
+func CountryCode(code string) Param {
+	return func(values url.Values) url.Values {
+		values.Set("search[country_code]", code)
+
+		return values
+	}
+}
+
+var _ Param = CountryCode("")
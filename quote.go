@@ -0,0 +1,89 @@
+package goodreads
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/BooleanCat/go-goodreads/param"
+)
+
+// A Quote contains information about a quote as defined by Goodreads.
+type Quote struct {
+	ID         int      `xml:"id"`
+	Body       string   `xml:"body"`
+	AuthorName string   `xml:"author_name"`
+	AuthorID   int      `xml:"author_id"`
+	BookID     int      `xml:"book_id"`
+	LikesCount int      `xml:"likes_count"`
+	Tags       []string `xml:"tags>tag"`
+}
+
+// QuoteOptions are the optional fields of a new quote. Zero valued fields are left unset.
+type QuoteOptions struct {
+	// AuthorID is the Goodreads ID of the quote's author.
+	AuthorID int
+
+	// BookID is the Goodreads ID of the book the quote is from.
+	BookID int
+
+	// ISBN is the ISBN-10 or ISBN-13 of the book the quote is from.
+	ISBN string
+
+	// Tags are the tags to give the quote.
+	Tags []string
+}
+
+func (options QuoteOptions) form(authorName, body string) (url.Values, error) {
+	form := url.Values{"quote[author_name]": {authorName}, "quote[body]": {body}}
+
+	if options.AuthorID != 0 {
+		form.Set("quote[author_id]", strconv.Itoa(options.AuthorID))
+	}
+
+	if options.BookID != 0 {
+		form.Set("quote[book_id]", strconv.Itoa(options.BookID))
+	}
+
+	if options.ISBN != "" {
+		isbn, err := NormalizeISBN(options.ISBN)
+		if err != nil {
+			return nil, err
+		}
+
+		form.Set("isbn", isbn)
+	}
+
+	if len(options.Tags) > 0 {
+		form.Set("quote[tags]", strings.Join(options.Tags, ","))
+	}
+
+	return form, nil
+}
+
+// QuoteCreate adds a quote, given its author's name and its text, as the user that authorized the client's access
+// token. An ErrInvalidISBN is returned without making a request when options.ISBN is malformed.
+func (client Client) QuoteCreate(ctx context.Context, authorName, body string, options QuoteOptions) (Quote, error) {
+	form, err := options.form(authorName, body)
+	if err != nil {
+		return Quote{}, err
+	}
+
+	var response struct {
+		Quote Quote `xml:"quote"`
+	}
+
+	if err := client.do(ctx, endpoint{
+		method: http.MethodPost,
+		path:   "/quotes",
+		params: []param.Param{formatXML},
+		form:   form,
+		signed: true,
+	}, &response); err != nil {
+		return Quote{}, err
+	}
+
+	return response.Quote, nil
+}
@@ -0,0 +1,68 @@
+package goodreads_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/BooleanCat/go-goodreads"
+	"github.com/BooleanCat/go-goodreads/internal/assert"
+	"github.com/BooleanCat/go-goodreads/internal/fakes"
+)
+
+func TestClient_QuoteCreate(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewBufferString(quoteResponseBody)),
+		StatusCode: http.StatusCreated,
+	}, nil)
+
+	client := signedClient(transport)
+
+	quote, err := client.QuoteCreate(context.Background(), "Frank Herbert", "Fear is the mind-killer.",
+		goodreads.QuoteOptions{AuthorID: 58, BookID: 234225, ISBN: "0-441-17271-7", Tags: []string{"fear", "dune"}})
+	assert.Nil(t, err)
+	assert.Equal(t, quote, goodreads.Quote{
+		ID:         9,
+		Body:       "Fear is the mind-killer.",
+		AuthorName: "Frank Herbert",
+		AuthorID:   58,
+		BookID:     234225,
+		Tags:       []string{"fear", "dune"},
+	})
+
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+	request := transport.RoundTripArgsForCall(0)
+	assert.Equal(t, request.Method, http.MethodPost)
+	assert.Equal(t, request.URL.String(), "https://www.goodreads.com/quotes?format=xml&key=key")
+	assertSignedForm(t, request, "isbn=9780441172719&quote%5Bauthor_id%5D=58&quote%5Bauthor_name%5D=Frank+Herbert"+
+		"&quote%5Bbody%5D=Fear+is+the+mind-killer.&quote%5Bbook_id%5D=234225&quote%5Btags%5D=fear%2Cdune")
+}
+
+func TestClient_QuoteCreate_InvalidISBN(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	client := signedClient(transport)
+
+	_, err := client.QuoteCreate(context.Background(), "Frank Herbert", "Fear is the mind-killer.",
+		goodreads.QuoteOptions{ISBN: "0441172718"})
+	assert.Equal(t, err, goodreads.ErrInvalidISBN{ISBN: "0441172718"})
+	assert.Equal(t, transport.RoundTripCallCount(), 0)
+}
+
+const quoteResponseBody string = `
+	<GoodreadsResponse>
+		<quote>
+			<id>9</id>
+			<body>Fear is the mind-killer.</body>
+			<author_name>Frank Herbert</author_name>
+			<author_id>58</author_id>
+			<book_id>234225</book_id>
+			<tags>
+				<tag>fear</tag>
+				<tag>dune</tag>
+			</tags>
+		</quote>
+	</GoodreadsResponse>
+`
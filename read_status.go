@@ -0,0 +1,36 @@
+package goodreads
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/BooleanCat/go-goodreads/param"
+)
+
+// A ReadStatus contains information about a change to the shelf a user has a book on as defined by Goodreads.
+type ReadStatus struct {
+	ID            int         `xml:"id"`
+	Status        string      `xml:"status"`
+	OldStatus     string      `xml:"old_status"`
+	UserID        int         `xml:"user_id"`
+	ReviewID      int         `xml:"review_id"`
+	UpdatedAt     string      `xml:"updated_at"`
+	User          UserCompact `xml:"user"`
+	Review        Review      `xml:"review"`
+	CommentsCount int         `xml:"comments_count"`
+	LikesCount    int         `xml:"likes_count"`
+}
+
+// ReadStatusShow returns a read status given a Goodreads read status ID.
+func (client Client) ReadStatusShow(ctx context.Context, id int) (ReadStatus, error) {
+	var response struct {
+		ReadStatus ReadStatus `xml:"read_status"`
+	}
+
+	e := endpoint{path: fmt.Sprintf("/read_statuses/%d", id), params: []param.Param{formatXML}}
+	if err := client.do(ctx, e, &response); err != nil {
+		return ReadStatus{}, err
+	}
+
+	return response.ReadStatus, nil
+}
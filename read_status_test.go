@@ -0,0 +1,78 @@
+package goodreads_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/BooleanCat/go-goodreads"
+	"github.com/BooleanCat/go-goodreads/internal/assert"
+	"github.com/BooleanCat/go-goodreads/internal/fakes"
+)
+
+func TestClient_ReadStatusShow(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewBufferString(readStatusResponseBody)),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+
+	status, err := client.ReadStatusShow(context.Background(), 55)
+	assert.Nil(t, err)
+	assert.Equal(t, status, goodreads.ReadStatus{
+		ID:            55,
+		Status:        "read",
+		OldStatus:     "currently-reading",
+		UserID:        213,
+		ReviewID:      77,
+		UpdatedAt:     "2020-01-06T10:00:00-08:00",
+		User:          goodreads.UserCompact{ID: 213, Name: "Foo Bar"},
+		Review:        goodreads.Review{ID: 77, Rating: 4},
+		CommentsCount: 2,
+		LikesCount:    5,
+	})
+
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+	request := transport.RoundTripArgsForCall(0)
+	assert.Equal(t, request.Method, http.MethodGet)
+	assert.Equal(t, request.URL.String(), "https://www.goodreads.com/read_statuses/55?format=xml&key=key")
+}
+
+func TestClient_ReadStatusShow_NotFound(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		StatusCode: http.StatusNotFound,
+	}, nil)
+
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+
+	_, err := client.ReadStatusShow(context.Background(), 55)
+	assert.True(t, goodreads.IsNotFound(err))
+}
+
+const readStatusResponseBody string = `
+	<GoodreadsResponse>
+		<read_status>
+			<id type="integer">55</id>
+			<status>read</status>
+			<old_status>currently-reading</old_status>
+			<user_id type="integer">213</user_id>
+			<review_id type="integer">77</review_id>
+			<updated_at type="datetime">2020-01-06T10:00:00-08:00</updated_at>
+			<user>
+				<id>213</id>
+				<name>Foo Bar</name>
+			</user>
+			<review>
+				<id>77</id>
+				<rating>4</rating>
+			</review>
+			<comments_count type="integer">2</comments_count>
+			<likes_count type="integer">5</likes_count>
+		</read_status>
+	</GoodreadsResponse>
+`
@@ -0,0 +1,39 @@
+package goodreads
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/BooleanCat/go-goodreads/param"
+)
+
+// A Recommendation contains information about a book recommended from one user to another as defined by Goodreads.
+type Recommendation struct {
+	ID            int         `xml:"id"`
+	Message       string      `xml:"message"`
+	CreatedAt     string      `xml:"created_at"`
+	FromUser      UserCompact `xml:"from_user"`
+	ToUser        UserCompact `xml:"to_user"`
+	Book          Book        `xml:"book"`
+	CommentsCount int         `xml:"comments_count"`
+	Comments      CommentList `xml:"comments"`
+}
+
+// RecommendationShow returns a recommendation given a Goodreads recommendation ID. Optional parameter param.Page may
+// be provided to page through the recommendation's comments.
+func (client Client) RecommendationShow(ctx context.Context, id int, params ...param.Param) (Recommendation, error) {
+	var response struct {
+		Recommendation Recommendation `xml:"recommendation"`
+	}
+
+	e := endpoint{
+		path:   fmt.Sprintf("/recommendations/%d", id),
+		params: append([]param.Param{formatXML}, params...),
+		signed: true,
+	}
+	if err := client.do(ctx, e, &response); err != nil {
+		return Recommendation{}, err
+	}
+
+	return response.Recommendation, nil
+}
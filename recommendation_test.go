@@ -0,0 +1,88 @@
+package goodreads_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/BooleanCat/go-goodreads"
+	"github.com/BooleanCat/go-goodreads/internal/assert"
+	"github.com/BooleanCat/go-goodreads/internal/fakes"
+)
+
+func TestClient_RecommendationShow(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewBufferString(recommendationResponseBody)),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := signedClient(transport)
+
+	recommendation, err := client.RecommendationShow(context.Background(), 66)
+	assert.Nil(t, err)
+	assert.Equal(t, recommendation, goodreads.Recommendation{
+		ID:            66,
+		Message:       "You'll love this.",
+		CreatedAt:     "2020-01-06T10:00:00-08:00",
+		FromUser:      goodreads.UserCompact{ID: 213, Name: "Foo Bar"},
+		ToUser:        goodreads.UserCompact{ID: 7, Name: "bcat"},
+		Book:          goodreads.Book{ID: 123, Title: "baz bar"},
+		CommentsCount: 1,
+		Comments: goodreads.CommentList{
+			Start:    1,
+			End:      1,
+			Total:    1,
+			Comments: []goodreads.Comment{{ID: 5, Body: "Thanks!"}},
+		},
+	})
+
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+	request := transport.RoundTripArgsForCall(0)
+	assert.Equal(t, request.Method, http.MethodGet)
+	assert.Equal(t, request.URL.String(), "https://www.goodreads.com/recommendations/66?format=xml&key=key")
+	assertSignedForm(t, request, "")
+}
+
+func TestClient_RecommendationShow_NotFound(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		StatusCode: http.StatusNotFound,
+	}, nil)
+
+	client := signedClient(transport)
+
+	_, err := client.RecommendationShow(context.Background(), 66)
+	assert.True(t, goodreads.IsNotFound(err))
+}
+
+const recommendationResponseBody string = `
+	<GoodreadsResponse>
+		<recommendation>
+			<id>66</id>
+			<message>You'll love this.</message>
+			<created_at>2020-01-06T10:00:00-08:00</created_at>
+			<from_user>
+				<id>213</id>
+				<name>Foo Bar</name>
+			</from_user>
+			<to_user>
+				<id>7</id>
+				<name>bcat</name>
+			</to_user>
+			<book>
+				<id>123</id>
+				<title>baz bar</title>
+			</book>
+			<comments_count>1</comments_count>
+			<comments start="1" end="1" total="1">
+				<comment>
+					<id>5</id>
+					<body>Thanks!</body>
+				</comment>
+			</comments>
+		</recommendation>
+	</GoodreadsResponse>
+`
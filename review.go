@@ -0,0 +1,247 @@
+package goodreads
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/BooleanCat/go-goodreads/param"
+)
+
+// A Review contains information about a review as defined by Goodreads.
+type Review struct {
+	ID             int     `xml:"id"`
+	User           User    `xml:"user"`
+	Book           Book    `xml:"book"`
+	Rating         int     `xml:"rating"`
+	Votes          int     `xml:"votes"`
+	SpoilerFlag    bool    `xml:"spoiler_flag"`
+	Shelves        []Shelf `xml:"shelves>shelf"`
+	RecommendedFor string  `xml:"recommended_for"`
+	RecommendedBy  string  `xml:"recommended_by"`
+	StartedAt      string  `xml:"started_at"`
+	ReadAt         string  `xml:"read_at"`
+	DateAdded      string  `xml:"date_added"`
+	DateUpdated    string  `xml:"date_updated"`
+	ReadCount      int     `xml:"read_count"`
+	Body           string  `xml:"body"`
+	CommentsCount  int     `xml:"comments_count"`
+	URL            string  `xml:"url"`
+	Link           string  `xml:"link"`
+	Owned          int     `xml:"owned"`
+}
+
+// A ReviewList is a page of reviews as defined by Goodreads.
+type ReviewList struct {
+	Start   int      `xml:"start,attr"`
+	End     int      `xml:"end,attr"`
+	Total   int      `xml:"total,attr"`
+	Reviews []Review `xml:"review"`
+}
+
+func (list ReviewList) items() []interface{} {
+	items := make([]interface{}, len(list.Reviews))
+	for i, review := range list.Reviews {
+		items[i] = review
+	}
+
+	return items
+}
+
+// ReviewOptions are the fields that may be set when creating or editing a review. Zero valued fields are left unset.
+type ReviewOptions struct {
+	// Rating is from 1 to 5 stars. Since 0 leaves the rating unset, ReviewEdit cannot clear a rating; use
+	// RatingDestroy instead.
+	Rating int
+
+	// Review is the text of the review.
+	Review string
+
+	// ReadAt is the date the book was read.
+	ReadAt time.Time
+
+	// Shelf is the name of the shelf to put the book on.
+	Shelf string
+
+	// Finished marks the book as finished reading. Only used when editing a review; ReviewCreate ignores it.
+	Finished bool
+}
+
+func (options ReviewOptions) form() (url.Values, error) {
+	form := url.Values{}
+
+	if options.Rating != 0 {
+		if err := validateRating(options.Rating); err != nil {
+			return nil, err
+		}
+
+		form.Set("review[rating]", strconv.Itoa(options.Rating))
+	}
+
+	if options.Review != "" {
+		form.Set("review[review]", options.Review)
+	}
+
+	if !options.ReadAt.IsZero() {
+		form.Set("review[read_at]", options.ReadAt.Format("2006-01-02"))
+	}
+
+	if options.Shelf != "" {
+		form.Set("shelf", options.Shelf)
+	}
+
+	if options.Finished {
+		form.Set("finished", "true")
+	}
+
+	return form, nil
+}
+
+func validateRating(rating int) error {
+	if rating < 1 || rating > 5 {
+		return ErrInvalidRating{Rating: rating}
+	}
+
+	return nil
+}
+
+// ReviewShow returns a review given a Goodreads review ID. Optional parameter param.Page may be provided to page
+// through the review's comments.
+func (client Client) ReviewShow(ctx context.Context, id int, params ...param.Param) (Review, error) {
+	var response struct {
+		Review Review `xml:"review"`
+	}
+
+	e := endpoint{path: "/review/show.xml", params: append([]param.Param{set("id", strconv.Itoa(id))}, params...)}
+	if err := client.do(ctx, e, &response); err != nil {
+		return Review{}, err
+	}
+
+	return response.Review, nil
+}
+
+// ReviewByUserAndBook returns a user's review of a book given a Goodreads user ID and book ID.
+func (client Client) ReviewByUserAndBook(ctx context.Context, userID, bookID int) (Review, error) {
+	var response struct {
+		Review Review `xml:"review"`
+	}
+
+	e := endpoint{path: "/review/show_by_user_and_book.xml", params: []param.Param{
+		set("user_id", strconv.Itoa(userID)),
+		set("book_id", strconv.Itoa(bookID)),
+	}}
+	if err := client.do(ctx, e, &response); err != nil {
+		return Review{}, err
+	}
+
+	return response.Review, nil
+}
+
+// ReviewList returns a page of the books on a user's shelves, along with the user's reviews of them, given a Goodreads
+// user ID. Optional parameters param.Shelf, param.Sort, param.Order, param.SearchQuery, param.PerPage or param.Page
+// may be provided.
+func (client Client) ReviewList(ctx context.Context, userID int, params ...param.Param) (ReviewList, error) {
+	var response struct {
+		Reviews ReviewList `xml:"reviews"`
+	}
+
+	e := endpoint{
+		path:   fmt.Sprintf("/review/list/%d.xml", userID),
+		params: append([]param.Param{set("v", "2")}, params...),
+	}
+	if err := client.do(ctx, e, &response); err != nil {
+		return ReviewList{}, err
+	}
+
+	return response.Reviews, nil
+}
+
+// ReviewListPager returns a Pager over every review of ReviewList. Each item is a Review.
+func (client Client) ReviewListPager(userID int, params ...param.Param) *Pager {
+	return NewPager(func(ctx context.Context, page int) ([]interface{}, int, int, error) {
+		reviews, err := client.ReviewList(ctx, userID, withPage(params, page)...)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+
+		return reviews.items(), reviews.End, reviews.Total, nil
+	})
+}
+
+// ReviewCreate reviews a book, given its Goodreads book ID, as the user that authorized the client's access token.
+func (client Client) ReviewCreate(ctx context.Context, bookID int, options ReviewOptions) (Review, error) {
+	options.Finished = false
+
+	form, err := options.form()
+	if err != nil {
+		return Review{}, err
+	}
+
+	form.Set("book_id", strconv.Itoa(bookID))
+
+	var response struct {
+		Review Review `xml:"review"`
+	}
+
+	e := endpoint{method: http.MethodPost, path: "/review.xml", form: form, signed: true}
+	if err := client.do(ctx, e, &response); err != nil {
+		return Review{}, err
+	}
+
+	return response.Review, nil
+}
+
+// ReviewEdit edits a review given its Goodreads review ID. Only the fields set in options are changed.
+func (client Client) ReviewEdit(ctx context.Context, id int, options ReviewOptions) (Review, error) {
+	form, err := options.form()
+	if err != nil {
+		return Review{}, err
+	}
+
+	var response struct {
+		Review Review `xml:"review"`
+	}
+
+	e := endpoint{method: http.MethodPost, path: fmt.Sprintf("/review/%d.xml", id), form: form, signed: true}
+	if err := client.do(ctx, e, &response); err != nil {
+		return Review{}, err
+	}
+
+	return response.Review, nil
+}
+
+// ReviewDestroy deletes a review given its Goodreads review ID.
+func (client Client) ReviewDestroy(ctx context.Context, id int) error {
+	return client.do(ctx, endpoint{
+		method: http.MethodPost,
+		path:   fmt.Sprintf("/review/destroy/%d", id),
+		params: []param.Param{formatXML},
+		signed: true,
+	}, nil)
+}
+
+// RatingCreate rates a book from 1 to 5 stars, given its Goodreads book ID, as the user that authorized the client's
+// access token.
+func (client Client) RatingCreate(ctx context.Context, bookID, rating int) error {
+	if err := validateRating(rating); err != nil {
+		return err
+	}
+
+	form := url.Values{"book_id": {strconv.Itoa(bookID)}, "rating": {strconv.Itoa(rating)}}
+
+	return client.do(ctx, endpoint{method: http.MethodPost, path: "/rating.xml", form: form, signed: true}, nil)
+}
+
+// RatingDestroy removes the rating of a book, given its Goodreads book ID, by the user that authorized the client's
+// access token.
+func (client Client) RatingDestroy(ctx context.Context, bookID int) error {
+	return client.do(ctx, endpoint{
+		method: http.MethodDelete,
+		path:   "/rating.xml",
+		params: []param.Param{set("book_id", strconv.Itoa(bookID))},
+		signed: true,
+	}, nil)
+}
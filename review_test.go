@@ -0,0 +1,398 @@
+package goodreads_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/BooleanCat/go-goodreads"
+	"github.com/BooleanCat/go-goodreads/internal/assert"
+	"github.com/BooleanCat/go-goodreads/internal/fakes"
+	"github.com/BooleanCat/go-goodreads/param"
+)
+
+func TestClient_ReviewShow(t *testing.T) {
+	responseBody := bytes.NewBufferString(reviewShowResponseBody)
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(responseBody),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+
+	review, err := client.ReviewShow(context.Background(), 99, param.Page(2))
+	assert.Nil(t, err)
+	assert.Equal(t, review, reviewFixture())
+
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+	request := transport.RoundTripArgsForCall(0)
+	assert.Equal(t, request.Method, http.MethodGet)
+	assert.Equal(t, request.URL.String(), "https://www.goodreads.com/review/show.xml?id=99&key=key&page=2")
+}
+
+func TestClient_ReviewShow_NotFound(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		StatusCode: http.StatusNotFound,
+	}, nil)
+
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+
+	_, err := client.ReviewShow(context.Background(), 99)
+	assert.True(t, goodreads.IsNotFound(err))
+}
+
+func TestClient_ReviewShow_DecodeFails(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(new(bytes.Buffer)),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+
+	_, err := client.ReviewShow(context.Background(), 99)
+	assert.ErrorMatches(t, err, `^decode response: `)
+}
+
+func TestClient_ReviewByUserAndBook(t *testing.T) {
+	responseBody := bytes.NewBufferString(reviewShowResponseBody)
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(responseBody),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+
+	review, err := client.ReviewByUserAndBook(context.Background(), 213, 123)
+	assert.Nil(t, err)
+	assert.Equal(t, review, reviewFixture())
+
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+	request := transport.RoundTripArgsForCall(0)
+	assert.Equal(t, request.URL.String(),
+		"https://www.goodreads.com/review/show_by_user_and_book.xml?book_id=123&key=key&user_id=213")
+}
+
+func TestClient_ReviewList(t *testing.T) {
+	responseBody := bytes.NewBufferString(reviewListResponseBody)
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(responseBody),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+
+	reviews, err := client.ReviewList(context.Background(), 213)
+	assert.Nil(t, err)
+	assert.Equal(t, reviews, goodreads.ReviewList{
+		Start: 1,
+		End:   2,
+		Total: 2,
+		Reviews: []goodreads.Review{
+			{ID: 99, Book: goodreads.Book{ID: 123, Title: "baz bar"}, Rating: 4},
+			{ID: 100, Book: goodreads.Book{ID: 124, Title: "foo"}, Rating: 2},
+		},
+	})
+
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+	request := transport.RoundTripArgsForCall(0)
+	assert.Equal(t, request.Method, http.MethodGet)
+	assert.Equal(t, request.URL.String(), "https://www.goodreads.com/review/list/213.xml?key=key&v=2")
+}
+
+func TestClient_ReviewList_OptionalParams(t *testing.T) {
+	responseBody := bytes.NewBufferString(reviewListResponseBody)
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(responseBody),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+
+	_, err := client.ReviewList(context.Background(), 213,
+		param.Shelf("read"),
+		param.Sort(param.SortDateRead),
+		param.Order(param.Descending),
+		param.SearchQuery("dick"),
+		param.PerPage(200),
+		param.Page(3),
+	)
+	assert.Nil(t, err)
+
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+	request := transport.RoundTripArgsForCall(0)
+	assert.EndsWith(t, request.URL.String(),
+		"key=key&order=d&page=3&per_page=200&search%5Bquery%5D=dick&shelf=read&sort=date_read&v=2")
+}
+
+func TestClient_ReviewList_NotFound(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		StatusCode: http.StatusNotFound,
+	}, nil)
+
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+
+	_, err := client.ReviewList(context.Background(), 213)
+	assert.True(t, goodreads.IsNotFound(err))
+}
+
+func TestClient_ReviewListPager(t *testing.T) {
+	responseBody := bytes.NewBufferString(reviewListResponseBody)
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(responseBody),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+	pager := client.ReviewListPager(213, param.Shelf("read"))
+
+	var titles []string
+	for pager.Next(context.Background()) {
+		titles = append(titles, pager.Item().(goodreads.Review).Book.Title)
+	}
+
+	assert.Nil(t, pager.Err())
+	assert.Equal(t, titles, []string{"baz bar", "foo"})
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+	assert.EndsWith(t, transport.RoundTripArgsForCall(0).URL.String(), "key=key&page=1&shelf=read&v=2")
+}
+
+func TestClient_ReviewCreate(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewBufferString(reviewShowResponseBody)),
+		StatusCode: http.StatusCreated,
+	}, nil)
+
+	client := signedClient(transport)
+
+	review, err := client.ReviewCreate(context.Background(), 123, goodreads.ReviewOptions{
+		Rating: 4,
+		Review: "Pretty good.",
+		ReadAt: time.Date(2020, time.February, 1, 0, 0, 0, 0, time.UTC),
+		Shelf:  "read",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, review, reviewFixture())
+
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+	request := transport.RoundTripArgsForCall(0)
+	assert.Equal(t, request.Method, http.MethodPost)
+	assert.Equal(t, request.URL.String(), "https://www.goodreads.com/review.xml?key=key")
+	assertSignedForm(t, request, "book_id=123&review%5Brating%5D=4&review%5Bread_at%5D=2020-02-01"+
+		"&review%5Breview%5D=Pretty+good.&shelf=read")
+}
+
+func TestClient_ReviewCreate_IgnoresFinished(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewBufferString(reviewShowResponseBody)),
+		StatusCode: http.StatusCreated,
+	}, nil)
+
+	client := signedClient(transport)
+
+	_, err := client.ReviewCreate(context.Background(), 123, goodreads.ReviewOptions{Shelf: "read", Finished: true})
+	assert.Nil(t, err)
+
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+	assertSignedForm(t, transport.RoundTripArgsForCall(0), "book_id=123&shelf=read")
+}
+
+func TestClient_ReviewCreate_InvalidRating(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	client := signedClient(transport)
+
+	_, err := client.ReviewCreate(context.Background(), 123, goodreads.ReviewOptions{Rating: 6})
+	assert.Equal(t, err, goodreads.ErrInvalidRating{Rating: 6})
+	assert.Equal(t, transport.RoundTripCallCount(), 0)
+}
+
+func TestClient_ReviewEdit(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewBufferString(reviewShowResponseBody)),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := signedClient(transport)
+
+	review, err := client.ReviewEdit(context.Background(), 99, goodreads.ReviewOptions{Finished: true})
+	assert.Nil(t, err)
+	assert.Equal(t, review, reviewFixture())
+
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+	request := transport.RoundTripArgsForCall(0)
+	assert.Equal(t, request.Method, http.MethodPost)
+	assert.Equal(t, request.URL.String(), "https://www.goodreads.com/review/99.xml?key=key")
+	assertSignedForm(t, request, "finished=true")
+}
+
+func TestClient_ReviewEdit_NotFound(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		StatusCode: http.StatusNotFound,
+	}, nil)
+
+	client := signedClient(transport)
+
+	_, err := client.ReviewEdit(context.Background(), 99, goodreads.ReviewOptions{Rating: 5})
+	assert.True(t, goodreads.IsNotFound(err))
+}
+
+func TestClient_ReviewDestroy(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(new(bytes.Buffer)),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := signedClient(transport)
+
+	assert.Nil(t, client.ReviewDestroy(context.Background(), 99))
+
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+	request := transport.RoundTripArgsForCall(0)
+	assert.Equal(t, request.Method, http.MethodPost)
+	assert.Equal(t, request.URL.String(), "https://www.goodreads.com/review/destroy/99?format=xml&key=key")
+	assertSignedForm(t, request, "")
+}
+
+func TestClient_RatingCreate(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(new(bytes.Buffer)),
+		StatusCode: http.StatusCreated,
+	}, nil)
+
+	client := signedClient(transport)
+
+	assert.Nil(t, client.RatingCreate(context.Background(), 123, 5))
+
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+	request := transport.RoundTripArgsForCall(0)
+	assert.Equal(t, request.Method, http.MethodPost)
+	assert.Equal(t, request.URL.String(), "https://www.goodreads.com/rating.xml?key=key")
+	assertSignedForm(t, request, "book_id=123&rating=5")
+}
+
+func TestClient_RatingCreate_InvalidRating(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	client := signedClient(transport)
+
+	err := client.RatingCreate(context.Background(), 123, 0)
+	assert.ErrorMatches(t, err, `^invalid rating: 0$`)
+	assert.Equal(t, transport.RoundTripCallCount(), 0)
+}
+
+func TestClient_RatingDestroy(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(new(bytes.Buffer)),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := signedClient(transport)
+
+	assert.Nil(t, client.RatingDestroy(context.Background(), 123))
+
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+	request := transport.RoundTripArgsForCall(0)
+	assert.Equal(t, request.Method, http.MethodDelete)
+	assert.Equal(t, request.URL.String(), "https://www.goodreads.com/rating.xml?book_id=123&key=key")
+	assertSignedForm(t, request, "")
+}
+
+const reviewListResponseBody string = `
+	<GoodreadsResponse>
+		<reviews start="1" end="2" total="2">
+			<review>
+				<id>99</id>
+				<book>
+					<id type="integer">123</id>
+					<title>baz bar</title>
+				</book>
+				<rating>4</rating>
+			</review>
+			<review>
+				<id>100</id>
+				<book>
+					<id type="integer">124</id>
+					<title>foo</title>
+				</book>
+				<rating>2</rating>
+			</review>
+		</reviews>
+	</GoodreadsResponse>
+`
+
+const reviewShowResponseBody string = `
+	<GoodreadsResponse>
+		<review>
+			<id>99</id>
+			<user>
+				<id>213</id>
+				<name>Foo Bar</name>
+			</user>
+			<book>
+				<id>123</id>
+				<title>baz bar</title>
+			</book>
+			<rating>4</rating>
+			<votes>3</votes>
+			<spoiler_flag>false</spoiler_flag>
+			<shelves>
+				<shelf name="read" exclusive="true" id="777" sortable="false" />
+				<shelf name="sci-fi" exclusive="false" id="778" sortable="true" />
+			</shelves>
+			<recommended_for>everyone</recommended_for>
+			<recommended_by>bcat</recommended_by>
+			<started_at>Mon Jan 06 00:00:00 -0800 2020</started_at>
+			<read_at>Sat Feb 01 00:00:00 -0800 2020</read_at>
+			<date_added>Sun Jan 05 10:00:00 -0800 2020</date_added>
+			<date_updated>Sat Feb 01 10:00:00 -0800 2020</date_updated>
+			<read_count>1</read_count>
+			<body><![CDATA[Pretty good.]]></body>
+			<comments_count>2</comments_count>
+			<url>https://foo.com/review</url>
+			<link>https://bar.com/review</link>
+			<owned>1</owned>
+		</review>
+	</GoodreadsResponse>
+`
+
+func reviewFixture() goodreads.Review {
+	return goodreads.Review{
+		ID:     99,
+		User:   goodreads.User{ID: 213, Name: "Foo Bar"},
+		Book:   goodreads.Book{ID: 123, Title: "baz bar"},
+		Rating: 4,
+		Votes:  3,
+		Shelves: []goodreads.Shelf{
+			{ID: 777, Name: "read", Exclusive: true},
+			{ID: 778, Name: "sci-fi", Sortable: true},
+		},
+		RecommendedFor: "everyone",
+		RecommendedBy:  "bcat",
+		StartedAt:      "Mon Jan 06 00:00:00 -0800 2020",
+		ReadAt:         "Sat Feb 01 00:00:00 -0800 2020",
+		DateAdded:      "Sun Jan 05 10:00:00 -0800 2020",
+		DateUpdated:    "Sat Feb 01 10:00:00 -0800 2020",
+		ReadCount:      1,
+		Body:           "Pretty good.",
+		CommentsCount:  2,
+		URL:            "https://foo.com/review",
+		Link:           "https://bar.com/review",
+		Owned:          1,
+	}
+}
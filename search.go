@@ -0,0 +1,54 @@
+package goodreads
+
+import (
+	"context"
+
+	"github.com/BooleanCat/go-goodreads/param"
+)
+
+// A Search contains the results of a search as defined by Goodreads.
+type Search struct {
+	Query            string  `xml:"query"`
+	ResultsStart     int     `xml:"results-start"`
+	ResultsEnd       int     `xml:"results-end"`
+	TotalResults     int     `xml:"total-results"`
+	Source           string  `xml:"source"`
+	QueryTimeSeconds float32 `xml:"query-time-seconds"`
+	Results          []Work  `xml:"results>work"`
+}
+
+func (search Search) items() []interface{} {
+	items := make([]interface{}, len(search.Results))
+	for i, work := range search.Results {
+		items[i] = work
+	}
+
+	return items
+}
+
+// SearchBooks finds books by title, author or ISBN. Optional parameters param.Page or param.SearchField may be
+// provided.
+func (client Client) SearchBooks(ctx context.Context, query string, params ...param.Param) (Search, error) {
+	var response struct {
+		Search Search `xml:"search"`
+	}
+
+	e := endpoint{path: "/search/index.xml", params: append([]param.Param{param.Query(query)}, params...)}
+	if err := client.do(ctx, e, &response); err != nil {
+		return Search{}, err
+	}
+
+	return response.Search, nil
+}
+
+// SearchBooksPager returns a Pager over every result of SearchBooks. Each item is a Work.
+func (client Client) SearchBooksPager(query string, params ...param.Param) *Pager {
+	return NewPager(func(ctx context.Context, page int) ([]interface{}, int, int, error) {
+		search, err := client.SearchBooks(ctx, query, withPage(params, page)...)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+
+		return search.items(), search.ResultsEnd, search.TotalResults, nil
+	})
+}
@@ -0,0 +1,149 @@
+package goodreads_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/BooleanCat/go-goodreads"
+	"github.com/BooleanCat/go-goodreads/internal/assert"
+	"github.com/BooleanCat/go-goodreads/internal/fakes"
+	"github.com/BooleanCat/go-goodreads/param"
+)
+
+func ExampleClient_SearchBooks() {
+	client := goodreads.Client{Client: &http.Client{Transport: rateLimit}}
+
+	search, err := client.SearchBooks(context.Background(), "Ubik", param.SearchField(param.FieldTitle))
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(search.Results[0].BestBook.Author.Name)
+	// Output:
+	// Philip K. Dick
+}
+
+func TestClient_SearchBooks(t *testing.T) {
+	responseBody := bytes.NewBufferString(searchBooksResponseBody)
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(responseBody),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+
+	search, err := client.SearchBooks(context.Background(), "foo bar")
+	assert.Nil(t, err)
+	assert.Equal(t, search, goodreads.Search{
+		Query:            "foo bar",
+		ResultsStart:     1,
+		ResultsEnd:       1,
+		TotalResults:     1,
+		Source:           "Goodreads",
+		QueryTimeSeconds: 0.15,
+		Results: []goodreads.Work{{
+			ID:                      42,
+			BooksCount:              5,
+			RatingsCount:            400,
+			TextReviewsCount:        50,
+			OriginalPublicationYear: 2019,
+			AverageRating:           4.09,
+			BestBook: goodreads.BestBook{
+				ID:            123,
+				Title:         "baz bar",
+				Author:        goodreads.Author{ID: 7, Name: "bcat"},
+				ImageURL:      "https://foo.com/bar.png",
+				SmallImageURL: "https://foo.com/baz.png",
+			},
+		}},
+	})
+
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+	request := transport.RoundTripArgsForCall(0)
+	assert.Equal(t, request.Method, http.MethodGet)
+	assert.Equal(t, request.URL.String(), "https://www.goodreads.com/search/index.xml?key=key&q=foo+bar")
+}
+
+func TestClient_SearchBooks_OptionalParams(t *testing.T) {
+	responseBody := bytes.NewBufferString(searchBooksResponseBody)
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(responseBody),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+
+	_, err := client.SearchBooks(context.Background(), "foo", param.Page(2), param.SearchField(param.FieldAuthor))
+	assert.Nil(t, err)
+
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+	request := transport.RoundTripArgsForCall(0)
+	assert.EndsWith(t, request.URL.String(), "key=key&page=2&q=foo&search%5Bfield%5D=author")
+}
+
+func TestClient_SearchBooks_InvalidStatusCode(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(new(bytes.Buffer)),
+		StatusCode: http.StatusMethodNotAllowed,
+	}, nil)
+
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+
+	_, err := client.SearchBooks(context.Background(), "foo")
+	assert.ErrorMatches(t, err, `^unexpected status code: 405$`)
+}
+
+func TestClient_SearchBooks_DecodeFails(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(new(bytes.Buffer)),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+
+	_, err := client.SearchBooks(context.Background(), "foo")
+	assert.ErrorMatches(t, err, `^decode response: `)
+}
+
+const searchBooksResponseBody string = `
+	<GoodreadsResponse>
+		<search>
+			<query><![CDATA[foo bar]]></query>
+			<results-start>1</results-start>
+			<results-end>1</results-end>
+			<total-results>1</total-results>
+			<source>Goodreads</source>
+			<query-time-seconds>0.15</query-time-seconds>
+			<results>
+				<work>
+					<id type="integer">42</id>
+					<books_count type="integer">5</books_count>
+					<ratings_count type="integer">400</ratings_count>
+					<text_reviews_count type="integer">50</text_reviews_count>
+					<original_publication_year type="integer">2019</original_publication_year>
+					<original_publication_month type="integer" nil="true"/>
+					<original_publication_day type="integer" nil="true"/>
+					<average_rating>4.09</average_rating>
+					<best_book type="Book">
+						<id type="integer">123</id>
+						<title>baz bar</title>
+						<author>
+							<id type="integer">7</id>
+							<name>bcat</name>
+						</author>
+						<image_url>https://foo.com/bar.png</image_url>
+						<small_image_url>https://foo.com/baz.png</small_image_url>
+					</best_book>
+				</work>
+			</results>
+		</search>
+	</GoodreadsResponse>
+`
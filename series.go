@@ -0,0 +1,48 @@
+package goodreads
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/BooleanCat/go-goodreads/param"
+)
+
+// SeriesShow returns a series, including its works in series order, given a Goodreads series ID.
+func (client Client) SeriesShow(ctx context.Context, id int) (Series, error) {
+	var response struct {
+		Series Series `xml:"series"`
+	}
+
+	if err := client.do(ctx, endpoint{path: fmt.Sprintf("/series/show/%d.xml", id)}, &response); err != nil {
+		return Series{}, err
+	}
+
+	return response.Series, nil
+}
+
+// SeriesByAuthor returns the series an author has written given a Goodreads author ID.
+func (client Client) SeriesByAuthor(ctx context.Context, authorID int) ([]SeriesWork, error) {
+	var response struct {
+		SeriesWorks []SeriesWork `xml:"series_works>series_work"`
+	}
+
+	if err := client.do(ctx, endpoint{path: fmt.Sprintf("/series/list/%d.xml", authorID)}, &response); err != nil {
+		return nil, err
+	}
+
+	return response.SeriesWorks, nil
+}
+
+// SeriesByWork returns the series a work belongs to given a Goodreads work ID.
+func (client Client) SeriesByWork(ctx context.Context, workID int64) ([]SeriesWork, error) {
+	var response struct {
+		SeriesWorks []SeriesWork `xml:"series_works>series_work"`
+	}
+
+	e := endpoint{path: fmt.Sprintf("/work/%d/series", workID), params: []param.Param{formatXML}}
+	if err := client.do(ctx, e, &response); err != nil {
+		return nil, err
+	}
+
+	return response.SeriesWorks, nil
+}
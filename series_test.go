@@ -0,0 +1,172 @@
+package goodreads_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/BooleanCat/go-goodreads"
+	"github.com/BooleanCat/go-goodreads/internal/assert"
+	"github.com/BooleanCat/go-goodreads/internal/fakes"
+)
+
+func TestClient_SeriesShow(t *testing.T) {
+	responseBody := bytes.NewBufferString(seriesShowResponseBody)
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(responseBody),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+
+	series, err := client.SeriesShow(context.Background(), 18)
+	assert.Nil(t, err)
+	assert.Equal(t, series, goodreads.Series{
+		ID:               18,
+		Title:            "foo",
+		Description:      "foo series",
+		SeriesWorksCount: 2,
+		PrimaryWorkCount: 2,
+		Numbered:         true,
+		SeriesWorks: []goodreads.SeriesWork{
+			{ID: 17, UserPosition: 1, Work: goodreads.Work{ID: 42, BestBook: goodreads.BestBook{ID: 123, Title: "baz"}}},
+			{ID: 19, UserPosition: 2, Work: goodreads.Work{ID: 43, BestBook: goodreads.BestBook{ID: 124, Title: "bar"}}},
+		},
+	})
+
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+	request := transport.RoundTripArgsForCall(0)
+	assert.Equal(t, request.Method, http.MethodGet)
+	assert.Equal(t, request.URL.String(), "https://www.goodreads.com/series/show/18.xml?key=key")
+}
+
+func TestClient_SeriesShow_NotFound(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		StatusCode: http.StatusNotFound,
+	}, nil)
+
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+
+	_, err := client.SeriesShow(context.Background(), 18)
+	assert.True(t, goodreads.IsNotFound(err))
+}
+
+func TestClient_SeriesByAuthor(t *testing.T) {
+	responseBody := bytes.NewBufferString(seriesWorksResponseBody)
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(responseBody),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+
+	seriesWorks, err := client.SeriesByAuthor(context.Background(), 7)
+	assert.Nil(t, err)
+	assert.Equal(t, seriesWorks, seriesWorksFixture())
+
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+	request := transport.RoundTripArgsForCall(0)
+	assert.Equal(t, request.URL.String(), "https://www.goodreads.com/series/list/7.xml?key=key")
+}
+
+func TestClient_SeriesByWork(t *testing.T) {
+	responseBody := bytes.NewBufferString(seriesWorksResponseBody)
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(responseBody),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+
+	seriesWorks, err := client.SeriesByWork(context.Background(), 42)
+	assert.Nil(t, err)
+	assert.Equal(t, seriesWorks, seriesWorksFixture())
+
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+	request := transport.RoundTripArgsForCall(0)
+	assert.Equal(t, request.URL.String(), "https://www.goodreads.com/work/42/series?format=xml&key=key")
+}
+
+func TestClient_SeriesByWork_DecodeFails(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(new(bytes.Buffer)),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+
+	_, err := client.SeriesByWork(context.Background(), 42)
+	assert.ErrorMatches(t, err, `^decode response: `)
+}
+
+const seriesShowResponseBody string = `
+	<GoodreadsResponse>
+		<series>
+			<id>18</id>
+			<title><![CDATA[foo]]></title>
+			<description><![CDATA[foo series]]></description>
+			<note />
+			<series_works_count>2</series_works_count>
+			<primary_work_count>2</primary_work_count>
+			<numbered>true</numbered>
+			<series_works>
+				<series_work>
+					<id>17</id>
+					<user_position>1</user_position>
+					<work>
+						<id>42</id>
+						<best_book>
+							<id>123</id>
+							<title>baz</title>
+						</best_book>
+					</work>
+				</series_work>
+				<series_work>
+					<id>19</id>
+					<user_position>2</user_position>
+					<work>
+						<id>43</id>
+						<best_book>
+							<id>124</id>
+							<title>bar</title>
+						</best_book>
+					</work>
+				</series_work>
+			</series_works>
+		</series>
+	</GoodreadsResponse>
+`
+
+const seriesWorksResponseBody string = `
+	<GoodreadsResponse>
+		<series_works>
+			<series_work>
+				<id>17</id>
+				<user_position>1</user_position>
+				<work>
+					<id>42</id>
+				</work>
+				<series>
+					<id>18</id>
+					<title><![CDATA[foo]]></title>
+				</series>
+			</series_work>
+		</series_works>
+	</GoodreadsResponse>
+`
+
+func seriesWorksFixture() []goodreads.SeriesWork {
+	return []goodreads.SeriesWork{{
+		ID:           17,
+		UserPosition: 1,
+		Work:         goodreads.Work{ID: 42},
+		Series:       goodreads.Series{ID: 18, Title: "foo"},
+	}}
+}
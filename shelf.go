@@ -0,0 +1,145 @@
+package goodreads
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/BooleanCat/go-goodreads/param"
+)
+
+// A UserShelf contains information about a shelf belonging to a user as defined by Goodreads.
+type UserShelf struct {
+	ID           int    `xml:"id"`
+	Name         string `xml:"name"`
+	BookCount    int    `xml:"book_count"`
+	Description  string `xml:"description"`
+	Exclusive    bool   `xml:"exclusive_flag"`
+	Sortable     bool   `xml:"sortable_flag"`
+	Featured     bool   `xml:"featured"`
+	RecommendFor bool   `xml:"recommend_for"`
+	Sticky       bool   `xml:"sticky"`
+}
+
+// A ShelfList is a page of a user's shelves as defined by Goodreads.
+type ShelfList struct {
+	Start   int         `xml:"start,attr"`
+	End     int         `xml:"end,attr"`
+	Total   int         `xml:"total,attr"`
+	Shelves []UserShelf `xml:"user_shelf"`
+}
+
+func (list ShelfList) items() []interface{} {
+	items := make([]interface{}, len(list.Shelves))
+	for i, shelf := range list.Shelves {
+		items[i] = shelf
+	}
+
+	return items
+}
+
+// ShelfOptions are the settings of a user's shelf.
+type ShelfOptions struct {
+	Exclusive bool
+	Sortable  bool
+	Featured  bool
+}
+
+func (options ShelfOptions) form(name string) url.Values {
+	return url.Values{
+		"user_shelf[name]":           {name},
+		"user_shelf[exclusive_flag]": {strconv.FormatBool(options.Exclusive)},
+		"user_shelf[sortable_flag]":  {strconv.FormatBool(options.Sortable)},
+		"user_shelf[featured]":       {strconv.FormatBool(options.Featured)},
+	}
+}
+
+// ShelvesList returns a page of a user's shelves given a Goodreads user ID. Optional parameter param.Page may be
+// provided.
+func (client Client) ShelvesList(ctx context.Context, userID int, params ...param.Param) (ShelfList, error) {
+	var response struct {
+		Shelves ShelfList `xml:"shelves"`
+	}
+
+	e := endpoint{path: "/shelf/list.xml", params: append([]param.Param{set("user_id", strconv.Itoa(userID))}, params...)}
+	if err := client.do(ctx, e, &response); err != nil {
+		return ShelfList{}, err
+	}
+
+	return response.Shelves, nil
+}
+
+// ShelvesListPager returns a Pager over every shelf of ShelvesList. Each item is a UserShelf.
+func (client Client) ShelvesListPager(userID int, params ...param.Param) *Pager {
+	return NewPager(func(ctx context.Context, page int) ([]interface{}, int, int, error) {
+		shelves, err := client.ShelvesList(ctx, userID, withPage(params, page)...)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+
+		return shelves.items(), shelves.End, shelves.Total, nil
+	})
+}
+
+// ShelfCreate creates a shelf for the user that authorized the client's access token.
+func (client Client) ShelfCreate(ctx context.Context, name string, options ShelfOptions) (UserShelf, error) {
+	var response struct {
+		Shelf UserShelf `xml:"user_shelf"`
+	}
+
+	e := endpoint{method: http.MethodPost, path: "/user_shelves.xml", form: options.form(name), signed: true}
+	if err := client.do(ctx, e, &response); err != nil {
+		return UserShelf{}, err
+	}
+
+	return response.Shelf, nil
+}
+
+// ShelfUpdate renames and replaces the settings of a shelf given its Goodreads shelf ID.
+func (client Client) ShelfUpdate(ctx context.Context, id int, name string, options ShelfOptions) error {
+	return client.do(ctx, endpoint{
+		method: http.MethodPut,
+		path:   fmt.Sprintf("/user_shelves/%d.xml", id),
+		form:   options.form(name),
+		signed: true,
+	}, nil)
+}
+
+// AddToShelf adds a book to one of the shelves of the user that authorized the client's access token.
+func (client Client) AddToShelf(ctx context.Context, shelf string, bookID int) error {
+	form := url.Values{"name": {shelf}, "book_id": {strconv.Itoa(bookID)}}
+
+	return client.do(ctx, endpoint{
+		method: http.MethodPost,
+		path:   "/shelf/add_to_shelf.xml",
+		form:   form,
+		signed: true,
+	}, nil)
+}
+
+// RemoveFromShelf removes a book from one of the shelves of the user that authorized the client's access token.
+func (client Client) RemoveFromShelf(ctx context.Context, shelf string, bookID int) error {
+	form := url.Values{"name": {shelf}, "book_id": {strconv.Itoa(bookID)}, "a": {"remove"}}
+
+	return client.do(ctx, endpoint{
+		method: http.MethodPost,
+		path:   "/shelf/add_to_shelf.xml",
+		form:   form,
+		signed: true,
+	}, nil)
+}
+
+// AddBooksToShelves adds every given book to every given shelf of the user that authorized the client's access token.
+func (client Client) AddBooksToShelves(ctx context.Context, bookIDs []int, shelves []string) error {
+	form := url.Values{"bookids": {joinIDs(bookIDs)}, "shelves": {strings.Join(shelves, ",")}}
+
+	return client.do(ctx, endpoint{
+		method: http.MethodPost,
+		path:   "/shelf/add_books_to_shelves.xml",
+		form:   form,
+		signed: true,
+	}, nil)
+}
@@ -0,0 +1,228 @@
+package goodreads_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/BooleanCat/go-goodreads"
+	"github.com/BooleanCat/go-goodreads/internal/assert"
+	"github.com/BooleanCat/go-goodreads/internal/fakes"
+)
+
+func TestClient_ShelvesList(t *testing.T) {
+	responseBody := bytes.NewBufferString(shelvesListResponseBody)
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(responseBody),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+
+	shelves, err := client.ShelvesList(context.Background(), 213)
+	assert.Nil(t, err)
+	assert.Equal(t, shelves, goodreads.ShelfList{
+		Start: 1,
+		End:   2,
+		Total: 2,
+		Shelves: []goodreads.UserShelf{
+			{ID: 777, Name: "read", BookCount: 120, Exclusive: true, Featured: true},
+			{ID: 778, Name: "sci-fi", BookCount: 40, Description: "Space!", Sortable: true},
+		},
+	})
+
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+	request := transport.RoundTripArgsForCall(0)
+	assert.Equal(t, request.Method, http.MethodGet)
+	assert.Equal(t, request.URL.String(), "https://www.goodreads.com/shelf/list.xml?key=key&user_id=213")
+}
+
+func TestClient_ShelvesListPager(t *testing.T) {
+	responseBody := bytes.NewBufferString(shelvesListResponseBody)
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(responseBody),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+	pager := client.ShelvesListPager(213)
+
+	var names []string
+	for pager.Next(context.Background()) {
+		names = append(names, pager.Item().(goodreads.UserShelf).Name)
+	}
+
+	assert.Nil(t, pager.Err())
+	assert.Equal(t, names, []string{"read", "sci-fi"})
+	assert.EndsWith(t, transport.RoundTripArgsForCall(0).URL.String(), "key=key&page=1&user_id=213")
+}
+
+func TestClient_ShelfCreate(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewBufferString(shelfCreateResponseBody)),
+		StatusCode: http.StatusCreated,
+	}, nil)
+
+	client := signedClient(transport)
+
+	shelf, err := client.ShelfCreate(context.Background(), "sci-fi", goodreads.ShelfOptions{Sortable: true})
+	assert.Nil(t, err)
+	assert.Equal(t, shelf, goodreads.UserShelf{ID: 778, Name: "sci-fi", Sortable: true})
+
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+	request := transport.RoundTripArgsForCall(0)
+	assert.Equal(t, request.Method, http.MethodPost)
+	assert.Equal(t, request.URL.String(), "https://www.goodreads.com/user_shelves.xml?key=key")
+	assertSignedForm(t, request, "user_shelf%5Bexclusive_flag%5D=false&user_shelf%5Bfeatured%5D=false"+
+		"&user_shelf%5Bname%5D=sci-fi&user_shelf%5Bsortable_flag%5D=true")
+}
+
+func TestClient_ShelfCreate_AccessTokenNotSet(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key", Secret: "secret"}
+
+	_, err := client.ShelfCreate(context.Background(), "sci-fi", goodreads.ShelfOptions{})
+	assert.Equal(t, err, goodreads.ErrAccessTokenNotSet{})
+	assert.Equal(t, transport.RoundTripCallCount(), 0)
+}
+
+func TestClient_ShelfUpdate(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(new(bytes.Buffer)),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := signedClient(transport)
+
+	err := client.ShelfUpdate(context.Background(), 778, "space", goodreads.ShelfOptions{Exclusive: true, Featured: true})
+	assert.Nil(t, err)
+
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+	request := transport.RoundTripArgsForCall(0)
+	assert.Equal(t, request.Method, http.MethodPut)
+	assert.Equal(t, request.URL.String(), "https://www.goodreads.com/user_shelves/778.xml?key=key")
+	assertSignedForm(t, request, "user_shelf%5Bexclusive_flag%5D=true&user_shelf%5Bfeatured%5D=true"+
+		"&user_shelf%5Bname%5D=space&user_shelf%5Bsortable_flag%5D=false")
+}
+
+func TestClient_ShelfUpdate_NotFound(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		StatusCode: http.StatusNotFound,
+	}, nil)
+
+	client := signedClient(transport)
+
+	err := client.ShelfUpdate(context.Background(), 778, "space", goodreads.ShelfOptions{})
+	assert.True(t, goodreads.IsNotFound(err))
+}
+
+func TestClient_AddToShelf(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(new(bytes.Buffer)),
+		StatusCode: http.StatusCreated,
+	}, nil)
+
+	client := signedClient(transport)
+
+	assert.Nil(t, client.AddToShelf(context.Background(), "sci-fi", 123))
+
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+	request := transport.RoundTripArgsForCall(0)
+	assert.Equal(t, request.Method, http.MethodPost)
+	assert.Equal(t, request.URL.String(), "https://www.goodreads.com/shelf/add_to_shelf.xml?key=key")
+	assertSignedForm(t, request, "book_id=123&name=sci-fi")
+}
+
+func TestClient_RemoveFromShelf(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(new(bytes.Buffer)),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := signedClient(transport)
+
+	assert.Nil(t, client.RemoveFromShelf(context.Background(), "sci-fi", 123))
+
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+	request := transport.RoundTripArgsForCall(0)
+	assert.Equal(t, request.Method, http.MethodPost)
+	assert.Equal(t, request.URL.String(), "https://www.goodreads.com/shelf/add_to_shelf.xml?key=key")
+	assertSignedForm(t, request, "a=remove&book_id=123&name=sci-fi")
+}
+
+func TestClient_AddBooksToShelves(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(new(bytes.Buffer)),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := signedClient(transport)
+
+	assert.Nil(t, client.AddBooksToShelves(context.Background(), []int{123, 124}, []string{"read", "sci-fi"}))
+
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+	request := transport.RoundTripArgsForCall(0)
+	assert.Equal(t, request.Method, http.MethodPost)
+	assert.Equal(t, request.URL.String(), "https://www.goodreads.com/shelf/add_books_to_shelves.xml?key=key")
+	assertSignedForm(t, request, "bookids=123%2C124&shelves=read%2Csci-fi")
+}
+
+func TestClient_AddBooksToShelves_InvalidStatusCode(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(new(bytes.Buffer)),
+		StatusCode: http.StatusUnprocessableEntity,
+	}, nil)
+
+	client := signedClient(transport)
+
+	err := client.AddBooksToShelves(context.Background(), []int{123}, []string{"read"})
+	assert.ErrorMatches(t, err, `^unexpected status code: 422$`)
+}
+
+const shelvesListResponseBody string = `
+	<GoodreadsResponse>
+		<shelves start="1" end="2" total="2">
+			<user_shelf>
+				<id type="integer">777</id>
+				<name>read</name>
+				<book_count type="integer">120</book_count>
+				<exclusive_flag type="boolean">true</exclusive_flag>
+				<description nil="true"/>
+				<featured type="boolean">true</featured>
+				<recommend_for type="boolean">false</recommend_for>
+				<sticky type="boolean" nil="true"/>
+			</user_shelf>
+			<user_shelf>
+				<id type="integer">778</id>
+				<name>sci-fi</name>
+				<book_count type="integer">40</book_count>
+				<exclusive_flag type="boolean">false</exclusive_flag>
+				<sortable_flag type="boolean">true</sortable_flag>
+				<description>Space!</description>
+				<featured type="boolean">false</featured>
+			</user_shelf>
+		</shelves>
+	</GoodreadsResponse>
+`
+
+const shelfCreateResponseBody string = `
+	<GoodreadsResponse>
+		<user_shelf>
+			<id type="integer">778</id>
+			<name>sci-fi</name>
+			<exclusive_flag type="boolean">false</exclusive_flag>
+			<sortable_flag type="boolean">true</sortable_flag>
+		</user_shelf>
+	</GoodreadsResponse>
+`
@@ -0,0 +1,174 @@
+package goodreads
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/BooleanCat/go-goodreads/param"
+)
+
+// A Topic contains information about a discussion topic as defined by Goodreads.
+type Topic struct {
+	ID            int         `xml:"id"`
+	Title         string      `xml:"title"`
+	CommentsCount int         `xml:"comments_count"`
+	LastCommentAt string      `xml:"last_comment_at"`
+	CreatedAt     string      `xml:"created_at"`
+	Author        UserCompact `xml:"author"`
+	Folder        Folder      `xml:"folder"`
+	Comments      CommentList `xml:"comments"`
+}
+
+// A TopicList is a page of discussion topics as defined by Goodreads.
+type TopicList struct {
+	Start  int     `xml:"start,attr"`
+	End    int     `xml:"end,attr"`
+	Total  int     `xml:"total,attr"`
+	Topics []Topic `xml:"topic"`
+}
+
+func (list TopicList) items() []interface{} {
+	items := make([]interface{}, len(list.Topics))
+	for i, topic := range list.Topics {
+		items[i] = topic
+	}
+
+	return items
+}
+
+// TopicOptions are the fields of a new discussion topic.
+type TopicOptions struct {
+	// FolderID is the Goodreads ID of the group folder to create the topic in.
+	FolderID int
+
+	// Title is the title of the topic.
+	Title string
+
+	// Body is the text of the topic's first comment.
+	Body string
+
+	// Question marks the topic as a question.
+	Question bool
+
+	// UpdateFeed adds the topic to the updates feed of the user creating it.
+	UpdateFeed bool
+}
+
+func (options TopicOptions) form(groupID int) url.Values {
+	form := url.Values{
+		"topic[subject_type]":    {"Group"},
+		"topic[subject_id]":      {strconv.Itoa(groupID)},
+		"topic[title]":           {options.Title},
+		"comment[body_usertext]": {options.Body},
+	}
+
+	if options.FolderID != 0 {
+		form.Set("topic[folder_id]", strconv.Itoa(options.FolderID))
+	}
+
+	if options.Question {
+		form.Set("topic[question_flag]", "1")
+	}
+
+	if options.UpdateFeed {
+		form.Set("update_feed", "1")
+	}
+
+	return form
+}
+
+// TopicShow returns a discussion topic, including a page of its comments, given a Goodreads topic ID. Optional
+// parameter param.Page may be provided to page through the topic's comments.
+func (client Client) TopicShow(ctx context.Context, id int, params ...param.Param) (Topic, error) {
+	var response struct {
+		Topic Topic `xml:"topic"`
+	}
+
+	e := endpoint{path: "/topic/show.xml", params: append([]param.Param{set("id", strconv.Itoa(id))}, params...)}
+	if err := client.do(ctx, e, &response); err != nil {
+		return Topic{}, err
+	}
+
+	return response.Topic, nil
+}
+
+// TopicCreate creates a discussion topic in a group, given a Goodreads group ID, as the user that authorized the
+// client's access token.
+func (client Client) TopicCreate(ctx context.Context, groupID int, options TopicOptions) (Topic, error) {
+	var response struct {
+		Topic Topic `xml:"topic"`
+	}
+
+	if err := client.do(ctx, endpoint{
+		method: http.MethodPost,
+		path:   "/topic.xml",
+		form:   options.form(groupID),
+		signed: true,
+	}, &response); err != nil {
+		return Topic{}, err
+	}
+
+	return response.Topic, nil
+}
+
+// TopicsInFolder returns a page of the discussion topics in a group's folder given Goodreads group and folder IDs.
+// Optional parameter param.Page may be provided.
+func (client Client) TopicsInFolder(
+	ctx context.Context, groupID, folderID int, params ...param.Param,
+) (TopicList, error) {
+	var response struct {
+		Topics TopicList `xml:"group_folder>topics"`
+	}
+
+	e := endpoint{
+		path:   fmt.Sprintf("/topic/group_folder/%d.xml", folderID),
+		params: append([]param.Param{set("group_id", strconv.Itoa(groupID))}, params...),
+	}
+	if err := client.do(ctx, e, &response); err != nil {
+		return TopicList{}, err
+	}
+
+	return response.Topics, nil
+}
+
+// TopicsInFolderPager returns a Pager over every topic of TopicsInFolder. Each item is a Topic.
+func (client Client) TopicsInFolderPager(groupID, folderID int, params ...param.Param) *Pager {
+	return NewPager(func(ctx context.Context, page int) ([]interface{}, int, int, error) {
+		topics, err := client.TopicsInFolder(ctx, groupID, folderID, withPage(params, page)...)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+
+		return topics.items(), topics.End, topics.Total, nil
+	})
+}
+
+// UnreadTopics returns a page of the discussion topics in a group, given a Goodreads group ID, with comments not yet
+// read by the user that authorized the client's access token. Optional parameter param.Page may be provided.
+func (client Client) UnreadTopics(ctx context.Context, groupID int, params ...param.Param) (TopicList, error) {
+	var response struct {
+		Topics TopicList `xml:"group_folder>topics"`
+	}
+
+	e := endpoint{path: fmt.Sprintf("/topic/unread_group/%d.xml", groupID), params: params, signed: true}
+	if err := client.do(ctx, e, &response); err != nil {
+		return TopicList{}, err
+	}
+
+	return response.Topics, nil
+}
+
+// UnreadTopicsPager returns a Pager over every topic of UnreadTopics. Each item is a Topic.
+func (client Client) UnreadTopicsPager(groupID int, params ...param.Param) *Pager {
+	return NewPager(func(ctx context.Context, page int) ([]interface{}, int, int, error) {
+		topics, err := client.UnreadTopics(ctx, groupID, withPage(params, page)...)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+
+		return topics.items(), topics.End, topics.Total, nil
+	})
+}
@@ -0,0 +1,231 @@
+package goodreads_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/BooleanCat/go-goodreads"
+	"github.com/BooleanCat/go-goodreads/internal/assert"
+	"github.com/BooleanCat/go-goodreads/internal/fakes"
+)
+
+func TestClient_TopicShow(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewBufferString(topicResponseBody)),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+
+	topic, err := client.TopicShow(context.Background(), 3)
+	assert.Nil(t, err)
+	assert.Equal(t, topic, goodreads.Topic{
+		ID:            3,
+		Title:         "What are you reading?",
+		CommentsCount: 1,
+		LastCommentAt: "2020-01-06T10:00:00-08:00",
+		CreatedAt:     "2020-01-05T10:00:00-08:00",
+		Author:        goodreads.UserCompact{ID: 213, Name: "Foo Bar"},
+		Folder:        goodreads.Folder{ID: 10, GroupID: 1, Title: "General"},
+		Comments: goodreads.CommentList{
+			Start:    1,
+			End:      1,
+			Total:    1,
+			Comments: []goodreads.Comment{{ID: 5, Body: "Dune."}},
+		},
+	})
+
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+	request := transport.RoundTripArgsForCall(0)
+	assert.Equal(t, request.Method, http.MethodGet)
+	assert.Equal(t, request.URL.String(), "https://www.goodreads.com/topic/show.xml?id=3&key=key")
+}
+
+func TestClient_TopicShow_NotFound(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		StatusCode: http.StatusNotFound,
+	}, nil)
+
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+
+	_, err := client.TopicShow(context.Background(), 3)
+	assert.True(t, goodreads.IsNotFound(err))
+}
+
+func TestClient_TopicCreate(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewBufferString(topicResponseBody)),
+		StatusCode: http.StatusCreated,
+	}, nil)
+
+	client := signedClient(transport)
+
+	topic, err := client.TopicCreate(context.Background(), 1, goodreads.TopicOptions{
+		FolderID:   10,
+		Title:      "What are you reading?",
+		Body:       "Dune.",
+		Question:   true,
+		UpdateFeed: true,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, topic.ID, 3)
+
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+	request := transport.RoundTripArgsForCall(0)
+	assert.Equal(t, request.Method, http.MethodPost)
+	assert.Equal(t, request.URL.String(), "https://www.goodreads.com/topic.xml?key=key")
+	assertSignedForm(t, request, "comment%5Bbody_usertext%5D=Dune."+
+		"&topic%5Bfolder_id%5D=10&topic%5Bquestion_flag%5D=1&topic%5Bsubject_id%5D=1&topic%5Bsubject_type%5D=Group"+
+		"&topic%5Btitle%5D=What+are+you+reading%3F&update_feed=1")
+}
+
+func TestClient_TopicsInFolder(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewBufferString(groupFolderResponseBody)),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+
+	topics, err := client.TopicsInFolder(context.Background(), 1, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, topics, topicListFixture())
+
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+	request := transport.RoundTripArgsForCall(0)
+	assert.Equal(t, request.URL.String(), "https://www.goodreads.com/topic/group_folder/10.xml?group_id=1&key=key")
+}
+
+func TestClient_TopicsInFolderPager(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewBufferString(groupFolderResponseBody)),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+	pager := client.TopicsInFolderPager(1, 10)
+
+	var titles []string
+	for pager.Next(context.Background()) {
+		titles = append(titles, pager.Item().(goodreads.Topic).Title)
+	}
+
+	assert.Nil(t, pager.Err())
+	assert.Equal(t, titles, []string{"What are you reading?", "Introductions"})
+	assert.EndsWith(t, transport.RoundTripArgsForCall(0).URL.String(), "group_id=1&key=key&page=1")
+}
+
+func TestClient_UnreadTopics(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewBufferString(groupFolderResponseBody)),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := signedClient(transport)
+
+	topics, err := client.UnreadTopics(context.Background(), 1)
+	assert.Nil(t, err)
+	assert.Equal(t, topics, topicListFixture())
+
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+	request := transport.RoundTripArgsForCall(0)
+	assert.Equal(t, request.URL.String(), "https://www.goodreads.com/topic/unread_group/1.xml?key=key")
+	assertSignedForm(t, request, "")
+}
+
+func TestClient_UnreadTopics_AccessTokenNotSet(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key", Secret: "secret"}
+
+	_, err := client.UnreadTopics(context.Background(), 1)
+	assert.Equal(t, err, goodreads.ErrAccessTokenNotSet{})
+	assert.Equal(t, transport.RoundTripCallCount(), 0)
+}
+
+func TestClient_UnreadTopicsPager(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewBufferString(groupFolderResponseBody)),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := signedClient(transport)
+	pager := client.UnreadTopicsPager(1)
+
+	var ids []int
+	for pager.Next(context.Background()) {
+		ids = append(ids, pager.Item().(goodreads.Topic).ID)
+	}
+
+	assert.Nil(t, pager.Err())
+	assert.Equal(t, ids, []int{3, 4})
+	assert.EndsWith(t, transport.RoundTripArgsForCall(0).URL.String(), "key=key&page=1")
+}
+
+const topicResponseBody string = `
+	<GoodreadsResponse>
+		<topic>
+			<id>3</id>
+			<title>What are you reading?</title>
+			<comments_count>1</comments_count>
+			<last_comment_at>2020-01-06T10:00:00-08:00</last_comment_at>
+			<created_at>2020-01-05T10:00:00-08:00</created_at>
+			<author>
+				<id>213</id>
+				<name>Foo Bar</name>
+			</author>
+			<folder>
+				<id>10</id>
+				<group_id>1</group_id>
+				<title>General</title>
+			</folder>
+			<comments start="1" end="1" total="1">
+				<comment>
+					<id>5</id>
+					<body>Dune.</body>
+				</comment>
+			</comments>
+		</topic>
+	</GoodreadsResponse>
+`
+
+const groupFolderResponseBody string = `
+	<GoodreadsResponse>
+		<group_folder>
+			<id>10</id>
+			<topics start="1" end="2" total="2">
+				<topic>
+					<id>3</id>
+					<title>What are you reading?</title>
+					<comments_count>1</comments_count>
+				</topic>
+				<topic>
+					<id>4</id>
+					<title>Introductions</title>
+					<comments_count>12</comments_count>
+				</topic>
+			</topics>
+		</group_folder>
+	</GoodreadsResponse>
+`
+
+func topicListFixture() goodreads.TopicList {
+	return goodreads.TopicList{
+		Start: 1,
+		End:   2,
+		Total: 2,
+		Topics: []goodreads.Topic{
+			{ID: 3, Title: "What are you reading?", CommentsCount: 1},
+			{ID: 4, Title: "Introductions", CommentsCount: 12},
+		},
+	}
+}
@@ -0,0 +1,87 @@
+package goodreads
+
+import (
+	"context"
+	"encoding/xml"
+
+	"github.com/BooleanCat/go-goodreads/param"
+)
+
+// An UpdateKind is the kind of an Update.
+type UpdateKind string
+
+// Kinds of Update.
+const (
+	UpdateKindReview        UpdateKind = "review"
+	UpdateKindUserStatus    UpdateKind = "userstatus"
+	UpdateKindReadStatus    UpdateKind = "readstatus"
+	UpdateKindUserChallenge UpdateKind = "userchallenge"
+)
+
+// An Update contains information about an entry in a user's updates feed as defined by Goodreads.
+//
+// Only the one of Review, UserStatus, ReadStatus or UserChallenge that matches Kind is set, and only when the update
+// includes it. All are nil when Kind is not one of the known UpdateKinds.
+type Update struct {
+	Kind          UpdateKind     `xml:"type,attr"`
+	ActionText    string         `xml:"action_text"`
+	Link          string         `xml:"link"`
+	ImageURL      string         `xml:"image_url"`
+	Actor         UserCompact    `xml:"actor"`
+	UpdatedAt     string         `xml:"updated_at"`
+	Review        *Review        `xml:"object>review"`
+	UserStatus    *UserStatus    `xml:"object>user_status"`
+	ReadStatus    *ReadStatus    `xml:"object>read_status"`
+	UserChallenge *UserChallenge `xml:"object>user_challenge"`
+}
+
+// UnmarshalXML implements xml.Unmarshaler, setting only the payload that matches the update's Kind.
+func (update *Update) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	type plain Update
+
+	var decoded plain
+	if err := decoder.DecodeElement(&decoded, &start); err != nil {
+		return err
+	}
+
+	*update = Update(decoded)
+	update.Review, update.UserStatus, update.ReadStatus, update.UserChallenge = nil, nil, nil, nil
+
+	switch decoded.Kind {
+	case UpdateKindReview:
+		update.Review = decoded.Review
+	case UpdateKindUserStatus:
+		update.UserStatus = decoded.UserStatus
+	case UpdateKindReadStatus:
+		update.ReadStatus = decoded.ReadStatus
+	case UpdateKindUserChallenge:
+		update.UserChallenge = decoded.UserChallenge
+	}
+
+	return nil
+}
+
+var _ xml.Unmarshaler = new(Update)
+
+// A UserChallenge contains information about a user's reading challenge as defined by Goodreads.
+type UserChallenge struct {
+	ID          int    `xml:"id"`
+	ChallengeID int    `xml:"challenge_id"`
+	UserID      int    `xml:"user_id"`
+	CreatedAt   string `xml:"created_at"`
+	UpdatedAt   string `xml:"updated_at"`
+}
+
+// FriendUpdates returns the updates feed of the user that authorized the client's access token. Optional parameters
+// param.Updates, param.UpdateFilter or param.MaxUpdates may be provided.
+func (client Client) FriendUpdates(ctx context.Context, params ...param.Param) ([]Update, error) {
+	var response struct {
+		Updates []Update `xml:"updates>update"`
+	}
+
+	if err := client.do(ctx, endpoint{path: "/updates/friends.xml", params: params, signed: true}, &response); err != nil {
+		return nil, err
+	}
+
+	return response.Updates, nil
+}
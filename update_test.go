@@ -0,0 +1,172 @@
+package goodreads_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/BooleanCat/go-goodreads"
+	"github.com/BooleanCat/go-goodreads/internal/assert"
+	"github.com/BooleanCat/go-goodreads/internal/fakes"
+	"github.com/BooleanCat/go-goodreads/param"
+)
+
+func TestClient_FriendUpdates(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewBufferString(friendUpdatesResponseBody)),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := signedClient(transport)
+
+	updates, err := client.FriendUpdates(context.Background(),
+		param.Updates(param.UpdateReviews), param.UpdateFilter(param.UpdatesFromFriends), param.MaxUpdates(4))
+	assert.Nil(t, err)
+	assert.Equal(t, updates, []goodreads.Update{
+		{
+			Kind:       goodreads.UpdateKindReview,
+			ActionText: "rated a book 4 stars",
+			Link:       "https://www.goodreads.com/review/show/77",
+			Actor:      goodreads.UserCompact{ID: 213, Name: "Foo Bar"},
+			UpdatedAt:  "Mon, 06 Jan 2020 10:00:00 -0800",
+			Review:     &goodreads.Review{ID: 77, Rating: 4},
+		},
+		{
+			Kind:       goodreads.UpdateKindUserStatus,
+			UserStatus: &goodreads.UserStatus{ID: 88, Page: 42},
+		},
+		{
+			Kind:       goodreads.UpdateKindReadStatus,
+			ReadStatus: &goodreads.ReadStatus{ID: 55, Status: "read"},
+		},
+		{
+			Kind:          goodreads.UpdateKindUserChallenge,
+			UserChallenge: &goodreads.UserChallenge{ID: 9, ChallengeID: 11, UserID: 213},
+		},
+		{
+			Kind: "friend",
+		},
+	})
+
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+	request := transport.RoundTripArgsForCall(0)
+	assert.Equal(t, request.Method, http.MethodGet)
+	assert.Equal(t, request.URL.String(),
+		"https://www.goodreads.com/updates/friends.xml?key=key&max_updates=4&update=reviews&update_filter=friends")
+	assertSignedForm(t, request, "")
+}
+
+func TestClient_FriendUpdates_MismatchedObject(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewBufferString(mismatchedUpdatesResponseBody)),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := signedClient(transport)
+
+	updates, err := client.FriendUpdates(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, updates, []goodreads.Update{
+		{Kind: goodreads.UpdateKindReview},
+		{Kind: goodreads.UpdateKindReadStatus, ReadStatus: &goodreads.ReadStatus{ID: 55}},
+		{Kind: "friend"},
+	})
+}
+
+func TestClient_FriendUpdates_AccessTokenNotSet(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key", Secret: "secret"}
+
+	_, err := client.FriendUpdates(context.Background())
+	assert.Equal(t, err, goodreads.ErrAccessTokenNotSet{})
+	assert.Equal(t, transport.RoundTripCallCount(), 0)
+}
+
+const friendUpdatesResponseBody string = `
+	<GoodreadsResponse>
+		<updates>
+			<update type="review">
+				<action_text>rated a book 4 stars</action_text>
+				<link>https://www.goodreads.com/review/show/77</link>
+				<actor>
+					<id>213</id>
+					<name>Foo Bar</name>
+				</actor>
+				<updated_at>Mon, 06 Jan 2020 10:00:00 -0800</updated_at>
+				<object>
+					<review>
+						<id>77</id>
+						<rating>4</rating>
+					</review>
+				</object>
+			</update>
+			<update type="userstatus">
+				<object>
+					<user_status>
+						<id>88</id>
+						<page>42</page>
+					</user_status>
+				</object>
+			</update>
+			<update type="readstatus">
+				<object>
+					<read_status>
+						<id>55</id>
+						<status>read</status>
+					</read_status>
+				</object>
+			</update>
+			<update type="userchallenge">
+				<object>
+					<user_challenge>
+						<id>9</id>
+						<challenge_id>11</challenge_id>
+						<user_id>213</user_id>
+					</user_challenge>
+				</object>
+			</update>
+			<update type="friend">
+				<object>
+					<friend>
+						<id>214</id>
+					</friend>
+				</object>
+			</update>
+		</updates>
+	</GoodreadsResponse>
+`
+
+const mismatchedUpdatesResponseBody string = `
+	<GoodreadsResponse>
+		<updates>
+			<update type="review">
+				<object>
+					<user_status>
+						<id>88</id>
+					</user_status>
+				</object>
+			</update>
+			<update type="readstatus">
+				<object>
+					<review>
+						<id>77</id>
+					</review>
+					<read_status>
+						<id>55</id>
+					</read_status>
+				</object>
+			</update>
+			<update type="friend">
+				<object>
+					<review>
+						<id>77</id>
+					</review>
+				</object>
+			</update>
+		</updates>
+	</GoodreadsResponse>
+`
@@ -2,9 +2,10 @@ package goodreads
 
 import (
 	"context"
-	"encoding/xml"
 	"fmt"
 	"net/http"
+
+	"github.com/BooleanCat/go-goodreads/param"
 )
 
 // A User contains information about a user as defined by Goodreads.
@@ -25,39 +26,118 @@ type User struct {
 	Interests     string `xml:"interests"`
 }
 
+// A UserCompact contains summary information about a user as defined by Goodreads.
+type UserCompact struct {
+	ID            int    `xml:"id"`
+	Name          string `xml:"name"`
+	Link          string `xml:"link"`
+	ImageURL      string `xml:"image_url"`
+	SmallImageURL string `xml:"small_image_url"`
+	FriendsCount  int    `xml:"friends_count"`
+	ReviewsCount  int    `xml:"reviews_count"`
+	CreatedAt     string `xml:"created_at"`
+}
+
+// A UserList is a page of users as defined by Goodreads.
+type UserList struct {
+	Start int           `xml:"start,attr"`
+	End   int           `xml:"end,attr"`
+	Total int           `xml:"total,attr"`
+	Users []UserCompact `xml:"user"`
+}
+
+func (list UserList) items() []interface{} {
+	items := make([]interface{}, len(list.Users))
+	for i, user := range list.Users {
+		items[i] = user
+	}
+
+	return items
+}
+
 // UserShow returns user information given a Goodreads user ID.
 func (client Client) UserShow(ctx context.Context, id int) (User, error) {
-	type goodreadsResponse struct {
+	var response struct {
 		User User `xml:"user"`
 	}
 
-	url := fmt.Sprintf("%s/user/show/%d.xml", client.getURL(), id)
-
-	request, err := client.newRequestWithKey(ctx, http.MethodGet, url, nil)
-	if err != nil {
+	if err := client.do(ctx, endpoint{path: fmt.Sprintf("/user/show/%d.xml", id)}, &response); err != nil {
 		return User{}, err
 	}
 
-	response, err := client.getClient().Do(request)
-	if err != nil {
-		return User{}, fmt.Errorf("do request: %w", err)
+	return response.User, nil
+}
+
+// Followers returns a page of a user's followers given a Goodreads user ID. Optional parameter param.Page may be
+// provided.
+func (client Client) Followers(ctx context.Context, userID int, params ...param.Param) (UserList, error) {
+	var response struct {
+		Followers UserList `xml:"followers"`
+	}
+
+	e := endpoint{path: fmt.Sprintf("/user/%d/followers.xml", userID), params: params, signed: true}
+	if err := client.do(ctx, e, &response); err != nil {
+		return UserList{}, err
 	}
 
-	defer closeIgnoreError(response.Body)
+	return response.Followers, nil
+}
+
+// FollowersPager returns a Pager over every user of Followers. Each item is a UserCompact.
+func (client Client) FollowersPager(userID int, params ...param.Param) *Pager {
+	return NewPager(func(ctx context.Context, page int) ([]interface{}, int, int, error) {
+		users, err := client.Followers(ctx, userID, withPage(params, page)...)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+
+		return users.items(), users.End, users.Total, nil
+	})
+}
 
-	switch response.StatusCode {
-	case http.StatusNotFound:
-		return User{}, ErrNotFound{}
-	case http.StatusOK:
-		break
-	default:
-		return User{}, ErrUnexpectedResponse{Code: response.StatusCode}
+// Following returns a page of the users a user is following given a Goodreads user ID. Optional parameter param.Page
+// may be provided.
+func (client Client) Following(ctx context.Context, userID int, params ...param.Param) (UserList, error) {
+	var response struct {
+		Following UserList `xml:"following"`
 	}
 
-	var user goodreadsResponse
-	if err := xml.NewDecoder(response.Body).Decode(&user); err != nil {
-		return User{}, fmt.Errorf("decode response: %w", err)
+	e := endpoint{path: fmt.Sprintf("/user/%d/following.xml", userID), params: params, signed: true}
+	if err := client.do(ctx, e, &response); err != nil {
+		return UserList{}, err
 	}
 
-	return user.User, nil
+	return response.Following, nil
+}
+
+// FollowingPager returns a Pager over every user of Following. Each item is a UserCompact.
+func (client Client) FollowingPager(userID int, params ...param.Param) *Pager {
+	return NewPager(func(ctx context.Context, page int) ([]interface{}, int, int, error) {
+		users, err := client.Following(ctx, userID, withPage(params, page)...)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+
+		return users.items(), users.End, users.Total, nil
+	})
+}
+
+// FollowUser follows a user, given a Goodreads user ID, as the user that authorized the client's access token.
+func (client Client) FollowUser(ctx context.Context, userID int) error {
+	return client.do(ctx, endpoint{
+		method: http.MethodPost,
+		path:   fmt.Sprintf("/user/%d/followers", userID),
+		params: []param.Param{formatXML},
+		signed: true,
+	}, nil)
+}
+
+// UnfollowUser stops following a user, given a Goodreads user ID, as the user that authorized the client's access
+// token.
+func (client Client) UnfollowUser(ctx context.Context, userID int) error {
+	return client.do(ctx, endpoint{
+		method: http.MethodDelete,
+		path:   fmt.Sprintf("/user/%d/followers/stop_following.xml", userID),
+		signed: true,
+	}, nil)
 }
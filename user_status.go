@@ -0,0 +1,135 @@
+package goodreads
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/BooleanCat/go-goodreads/param"
+)
+
+// A UserStatus contains information about an update to a user's reading progress as defined by Goodreads.
+type UserStatus struct {
+	ID            int         `xml:"id"`
+	Header        string      `xml:"header"`
+	Body          string      `xml:"body"`
+	CreatedAt     string      `xml:"created_at"`
+	UpdatedAt     string      `xml:"updated_at"`
+	Page          int         `xml:"page"`
+	Percent       int         `xml:"percent"`
+	WorkID        int64       `xml:"work_id"`
+	UserID        int         `xml:"user_id"`
+	User          UserCompact `xml:"user"`
+	Book          Book        `xml:"book"`
+	CommentsCount int         `xml:"comments_count"`
+	LikesCount    int         `xml:"likes_count"`
+}
+
+// Progress is how far a user has read through a book, measured either in pages or as a percentage. The zero value is
+// not valid; use Pages or Percent.
+type Progress struct {
+	value   int
+	percent bool
+	set     bool
+}
+
+// Pages is progress measured by the page a user has read up to.
+func Pages(page int) Progress {
+	return Progress{value: page, set: true}
+}
+
+// Percent is progress measured by the percentage of a book a user has read.
+func Percent(percent int) Progress {
+	return Progress{value: percent, percent: true, set: true}
+}
+
+func (progress Progress) String() string {
+	switch {
+	case !progress.set:
+		return "unset"
+	case progress.percent:
+		return fmt.Sprintf("%d%%", progress.value)
+	default:
+		return fmt.Sprintf("page %d", progress.value)
+	}
+}
+
+func (progress Progress) validate() error {
+	if !progress.set || progress.value < 0 || (progress.percent && progress.value > 100) {
+		return ErrInvalidProgress{Progress: progress}
+	}
+
+	return nil
+}
+
+// UserStatusCreate updates the reading progress of the user that authorized the client's access token for a book given
+// its Goodreads book ID. The body is an optional comment.
+func (client Client) UserStatusCreate(
+	ctx context.Context, bookID int, progress Progress, body string,
+) (UserStatus, error) {
+	if err := progress.validate(); err != nil {
+		return UserStatus{}, err
+	}
+
+	form := url.Values{"user_status[book_id]": {strconv.Itoa(bookID)}}
+
+	if progress.percent {
+		form.Set("user_status[percent]", strconv.Itoa(progress.value))
+	} else {
+		form.Set("user_status[page]", strconv.Itoa(progress.value))
+	}
+
+	if body != "" {
+		form.Set("user_status[body]", body)
+	}
+
+	var response struct {
+		UserStatus UserStatus `xml:"user_status"`
+	}
+
+	e := endpoint{method: http.MethodPost, path: "/user_status.xml", form: form, signed: true}
+	if err := client.do(ctx, e, &response); err != nil {
+		return UserStatus{}, err
+	}
+
+	return response.UserStatus, nil
+}
+
+// UserStatusShow returns a user status given a Goodreads user status ID.
+func (client Client) UserStatusShow(ctx context.Context, id int) (UserStatus, error) {
+	var response struct {
+		UserStatus UserStatus `xml:"user_status"`
+	}
+
+	e := endpoint{path: fmt.Sprintf("/user_status/show/%d", id), params: []param.Param{formatXML}}
+	if err := client.do(ctx, e, &response); err != nil {
+		return UserStatus{}, err
+	}
+
+	return response.UserStatus, nil
+}
+
+// UserStatusDestroy deletes a user status given a Goodreads user status ID.
+func (client Client) UserStatusDestroy(ctx context.Context, id int) error {
+	return client.do(ctx, endpoint{
+		method: http.MethodPost,
+		path:   fmt.Sprintf("/user_status/destroy/%d", id),
+		params: []param.Param{formatXML},
+		signed: true,
+	}, nil)
+}
+
+// UserStatusIndex returns the most recent user statuses.
+func (client Client) UserStatusIndex(ctx context.Context) ([]UserStatus, error) {
+	var response struct {
+		UserStatuses []UserStatus `xml:"user_statuses>user_status"`
+	}
+
+	if err := client.do(ctx, endpoint{path: "/user_status/index.xml"}, &response); err != nil {
+		return nil, err
+	}
+
+	return response.UserStatuses, nil
+}
@@ -0,0 +1,199 @@
+package goodreads_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/BooleanCat/go-goodreads"
+	"github.com/BooleanCat/go-goodreads/internal/assert"
+	"github.com/BooleanCat/go-goodreads/internal/fakes"
+)
+
+func TestProgress_String(t *testing.T) {
+	assert.Equal(t, fmt.Sprint(goodreads.Pages(42)), "page 42")
+	assert.Equal(t, fmt.Sprint(goodreads.Percent(50)), "50%")
+	assert.Equal(t, fmt.Sprint(goodreads.Progress{}), "unset")
+}
+
+func TestClient_UserStatusCreate(t *testing.T) {
+	responseBody := bytes.NewBufferString(userStatusResponseBody)
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(responseBody),
+		StatusCode: http.StatusCreated,
+	}, nil)
+
+	client := signedClient(transport)
+
+	status, err := client.UserStatusCreate(context.Background(), 123, goodreads.Pages(42), "Getting good.")
+	assert.Nil(t, err)
+	assert.Equal(t, status, userStatusFixture())
+
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+	request := transport.RoundTripArgsForCall(0)
+	assert.Equal(t, request.Method, http.MethodPost)
+	assert.Equal(t, request.URL.String(), "https://www.goodreads.com/user_status.xml?key=key")
+	assertSignedForm(t, request,
+		"user_status%5Bbody%5D=Getting+good.&user_status%5Bbook_id%5D=123&user_status%5Bpage%5D=42")
+}
+
+func TestClient_UserStatusCreate_Percent(t *testing.T) {
+	responseBody := bytes.NewBufferString(userStatusResponseBody)
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(responseBody),
+		StatusCode: http.StatusCreated,
+	}, nil)
+
+	client := signedClient(transport)
+
+	_, err := client.UserStatusCreate(context.Background(), 123, goodreads.Percent(20), "")
+	assert.Nil(t, err)
+
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+	assertSignedForm(t, transport.RoundTripArgsForCall(0), "user_status%5Bbook_id%5D=123&user_status%5Bpercent%5D=20")
+}
+
+func TestClient_UserStatusCreate_InvalidProgress(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	client := signedClient(transport)
+
+	for _, progress := range []goodreads.Progress{{}, goodreads.Pages(-1), goodreads.Percent(101)} {
+		_, err := client.UserStatusCreate(context.Background(), 123, progress, "")
+		assert.Equal(t, err, goodreads.ErrInvalidProgress{Progress: progress})
+	}
+
+	_, err := client.UserStatusCreate(context.Background(), 123, goodreads.Percent(101), "")
+	assert.ErrorMatches(t, err, `^invalid progress: 101%$`)
+	assert.Equal(t, transport.RoundTripCallCount(), 0)
+}
+
+func TestClient_UserStatusShow(t *testing.T) {
+	responseBody := bytes.NewBufferString(userStatusResponseBody)
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(responseBody),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+
+	status, err := client.UserStatusShow(context.Background(), 88)
+	assert.Nil(t, err)
+	assert.Equal(t, status, userStatusFixture())
+
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+	request := transport.RoundTripArgsForCall(0)
+	assert.Equal(t, request.Method, http.MethodGet)
+	assert.Equal(t, request.URL.String(), "https://www.goodreads.com/user_status/show/88?format=xml&key=key")
+}
+
+func TestClient_UserStatusShow_NotFound(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		StatusCode: http.StatusNotFound,
+	}, nil)
+
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+
+	_, err := client.UserStatusShow(context.Background(), 88)
+	assert.True(t, goodreads.IsNotFound(err))
+}
+
+func TestClient_UserStatusDestroy(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(new(bytes.Buffer)),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := signedClient(transport)
+
+	assert.Nil(t, client.UserStatusDestroy(context.Background(), 88))
+
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+	request := transport.RoundTripArgsForCall(0)
+	assert.Equal(t, request.Method, http.MethodPost)
+	assert.Equal(t, request.URL.String(), "https://www.goodreads.com/user_status/destroy/88?format=xml&key=key")
+	assertSignedForm(t, request, "")
+}
+
+func TestClient_UserStatusIndex(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewBufferString(userStatusIndexResponseBody)),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+
+	statuses, err := client.UserStatusIndex(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, statuses, []goodreads.UserStatus{{ID: 88, Page: 42}, {ID: 89, Percent: 20}})
+
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+	request := transport.RoundTripArgsForCall(0)
+	assert.Equal(t, request.URL.String(), "https://www.goodreads.com/user_status/index.xml?key=key")
+}
+
+const userStatusResponseBody string = `
+	<GoodreadsResponse>
+		<user_status>
+			<id type="integer">88</id>
+			<header>Foo Bar is on page 42 of 201 of baz bar</header>
+			<body>Getting good.</body>
+			<created_at type="datetime">2020-01-06T10:00:00-08:00</created_at>
+			<updated_at type="datetime">2020-01-06T10:00:00-08:00</updated_at>
+			<page type="integer">42</page>
+			<percent type="integer" nil="true"/>
+			<work_id type="integer">42</work_id>
+			<user_id type="integer">213</user_id>
+			<user>
+				<id>213</id>
+				<name>Foo Bar</name>
+			</user>
+			<book>
+				<id>123</id>
+				<title>baz bar</title>
+			</book>
+			<comments_count type="integer">1</comments_count>
+			<likes_count type="integer">3</likes_count>
+		</user_status>
+	</GoodreadsResponse>
+`
+
+const userStatusIndexResponseBody string = `
+	<GoodreadsResponse>
+		<user_statuses>
+			<user_status>
+				<id>88</id>
+				<page>42</page>
+			</user_status>
+			<user_status>
+				<id>89</id>
+				<percent>20</percent>
+			</user_status>
+		</user_statuses>
+	</GoodreadsResponse>
+`
+
+func userStatusFixture() goodreads.UserStatus {
+	return goodreads.UserStatus{
+		ID:            88,
+		Header:        "Foo Bar is on page 42 of 201 of baz bar",
+		Body:          "Getting good.",
+		CreatedAt:     "2020-01-06T10:00:00-08:00",
+		UpdatedAt:     "2020-01-06T10:00:00-08:00",
+		Page:          42,
+		WorkID:        42,
+		UserID:        213,
+		User:          goodreads.UserCompact{ID: 213, Name: "Foo Bar"},
+		Book:          goodreads.Book{ID: 123, Title: "baz bar"},
+		CommentsCount: 1,
+		LikesCount:    3,
+	}
+}
@@ -9,14 +9,13 @@ import (
 	"testing"
 
 	"github.com/BooleanCat/go-goodreads"
-	"github.com/BooleanCat/go-goodreads/httputils"
 	"github.com/BooleanCat/go-goodreads/internal/assert"
 	"github.com/BooleanCat/go-goodreads/internal/fakes"
+	"github.com/BooleanCat/go-goodreads/param"
 )
 
 func ExampleClient_UserShow() {
-	transport := httputils.DripLimit(http.DefaultTransport, ticker)
-	client := goodreads.Client{Client: &http.Client{Transport: transport}}
+	client := goodreads.Client{Client: &http.Client{Transport: rateLimit}}
 
 	user, err := client.UserShow(context.Background(), 101333864)
 	if err != nil {
@@ -124,6 +123,133 @@ func TestClient_UserShow_NotFound(t *testing.T) {
 	assert.True(t, goodreads.IsNotFound(err))
 }
 
+func TestClient_Followers(t *testing.T) {
+	responseBody := bytes.NewBufferString(followersResponseBody)
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(responseBody),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := signedClient(transport)
+
+	followers, err := client.Followers(context.Background(), 213)
+	assert.Nil(t, err)
+	assert.Equal(t, followers, goodreads.UserList{
+		Start: 1,
+		End:   1,
+		Total: 1,
+		Users: []goodreads.UserCompact{userCompactFixture()},
+	})
+
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+	request := transport.RoundTripArgsForCall(0)
+	assert.Equal(t, request.Method, http.MethodGet)
+	assert.Equal(t, request.URL.String(), "https://www.goodreads.com/user/213/followers.xml?key=key")
+	assertSignedForm(t, request, "")
+}
+
+func TestClient_Followers_AccessTokenNotSet(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key", Secret: "secret"}
+
+	_, err := client.Followers(context.Background(), 213)
+	assert.Equal(t, err, goodreads.ErrAccessTokenNotSet{})
+	assert.Equal(t, transport.RoundTripCallCount(), 0)
+}
+
+func TestClient_FollowersPager(t *testing.T) {
+	responseBody := bytes.NewBufferString(followersResponseBody)
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(responseBody),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := signedClient(transport)
+	pager := client.FollowersPager(213)
+
+	var users []interface{}
+	for pager.Next(context.Background()) {
+		users = append(users, pager.Item())
+	}
+
+	assert.Nil(t, pager.Err())
+	assert.Equal(t, users, []interface{}{userCompactFixture()})
+	assert.EndsWith(t, transport.RoundTripArgsForCall(0).URL.String(), "key=key&page=1")
+}
+
+func TestClient_Following(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewBufferString(followingResponseBody)),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := signedClient(transport)
+
+	following, err := client.Following(context.Background(), 213, param.Page(2))
+	assert.Nil(t, err)
+	assert.Equal(t, following, goodreads.UserList{
+		Start: 31,
+		End:   31,
+		Total: 31,
+		Users: []goodreads.UserCompact{{ID: 7, Name: "bcat"}},
+	})
+
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+	request := transport.RoundTripArgsForCall(0)
+	assert.Equal(t, request.URL.String(), "https://www.goodreads.com/user/213/following.xml?key=key&page=2")
+}
+
+func TestClient_FollowUser(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(new(bytes.Buffer)),
+		StatusCode: http.StatusCreated,
+	}, nil)
+
+	client := signedClient(transport)
+
+	assert.Nil(t, client.FollowUser(context.Background(), 7))
+
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+	request := transport.RoundTripArgsForCall(0)
+	assert.Equal(t, request.Method, http.MethodPost)
+	assert.Equal(t, request.URL.String(), "https://www.goodreads.com/user/7/followers?format=xml&key=key")
+	assertSignedForm(t, request, "")
+}
+
+func TestClient_UnfollowUser(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(new(bytes.Buffer)),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := signedClient(transport)
+
+	assert.Nil(t, client.UnfollowUser(context.Background(), 7))
+
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+	request := transport.RoundTripArgsForCall(0)
+	assert.Equal(t, request.Method, http.MethodDelete)
+	assert.Equal(t, request.URL.String(), "https://www.goodreads.com/user/7/followers/stop_following.xml?key=key")
+	assertSignedForm(t, request, "")
+}
+
+func TestClient_UnfollowUser_NotFound(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		StatusCode: http.StatusNotFound,
+	}, nil)
+
+	client := signedClient(transport)
+
+	err := client.UnfollowUser(context.Background(), 7)
+	assert.True(t, goodreads.IsNotFound(err))
+}
+
 const userShowResponseBody string = `
 	<goodreads_response>
 		<user>
@@ -144,3 +270,44 @@ const userShowResponseBody string = `
 		</user>
 	</goodreads_response>
 `
+
+const followersResponseBody string = `
+	<GoodreadsResponse>
+		<followers start="1" end="1" total="1">
+			<user>
+				<id>42</id>
+				<name>Baz Qux</name>
+				<link><![CDATA[https://foo.com/bqux]]></link>
+				<image_url><![CDATA[https://foo.com/bqux.png]]></image_url>
+				<small_image_url><![CDATA[https://foo.com/bquxmini.png]]></small_image_url>
+				<friends_count type="integer">12</friends_count>
+				<reviews_count type="integer">34</reviews_count>
+				<created_at>Sun Jan 05 10:00:00 -0800 2020</created_at>
+			</user>
+		</followers>
+	</GoodreadsResponse>
+`
+
+const followingResponseBody string = `
+	<GoodreadsResponse>
+		<following start="31" end="31" total="31">
+			<user>
+				<id>7</id>
+				<name>bcat</name>
+			</user>
+		</following>
+	</GoodreadsResponse>
+`
+
+func userCompactFixture() goodreads.UserCompact {
+	return goodreads.UserCompact{
+		ID:            42,
+		Name:          "Baz Qux",
+		Link:          "https://foo.com/bqux",
+		ImageURL:      "https://foo.com/bqux.png",
+		SmallImageURL: "https://foo.com/bquxmini.png",
+		FriendsCount:  12,
+		ReviewsCount:  34,
+		CreatedAt:     "Sun Jan 05 10:00:00 -0800 2020",
+	}
+}
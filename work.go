@@ -0,0 +1,35 @@
+package goodreads
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/BooleanCat/go-goodreads/param"
+)
+
+// WorkEditions returns a page of the editions of a work given a Goodreads work ID. Optional parameter param.Page may
+// be provided.
+func (client Client) WorkEditions(ctx context.Context, workID int64, params ...param.Param) (BookList, error) {
+	var response struct {
+		Editions BookList `xml:"editions"`
+	}
+
+	e := endpoint{path: fmt.Sprintf("/work/editions/%d", workID), params: append([]param.Param{formatXML}, params...)}
+	if err := client.do(ctx, e, &response); err != nil {
+		return BookList{}, err
+	}
+
+	return response.Editions, nil
+}
+
+// WorkEditionsPager returns a Pager over every edition of WorkEditions. Each item is a Book.
+func (client Client) WorkEditionsPager(workID int64, params ...param.Param) *Pager {
+	return NewPager(func(ctx context.Context, page int) ([]interface{}, int, int, error) {
+		editions, err := client.WorkEditions(ctx, workID, withPage(params, page)...)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+
+		return editions.items(), editions.End, editions.Total, nil
+	})
+}
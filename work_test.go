@@ -0,0 +1,92 @@
+package goodreads_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/BooleanCat/go-goodreads"
+	"github.com/BooleanCat/go-goodreads/internal/assert"
+	"github.com/BooleanCat/go-goodreads/internal/fakes"
+	"github.com/BooleanCat/go-goodreads/param"
+)
+
+func TestClient_WorkEditions(t *testing.T) {
+	responseBody := bytes.NewBufferString(workEditionsResponseBody)
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(responseBody),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+
+	editions, err := client.WorkEditions(context.Background(), 42, param.Page(3))
+	assert.Nil(t, err)
+	assert.Equal(t, editions, goodreads.BookList{
+		Start: 41,
+		End:   42,
+		Total: 42,
+		Books: []goodreads.Book{
+			{ID: 123, Title: "baz bar", Format: "Paperback"},
+			{ID: 124, Title: "baz bar", Format: "Kindle"},
+		},
+	})
+
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+	request := transport.RoundTripArgsForCall(0)
+	assert.Equal(t, request.Method, http.MethodGet)
+	assert.Equal(t, request.URL.String(), "https://www.goodreads.com/work/editions/42?format=xml&key=key&page=3")
+}
+
+func TestClient_WorkEditions_NotFound(t *testing.T) {
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		StatusCode: http.StatusNotFound,
+	}, nil)
+
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+
+	_, err := client.WorkEditions(context.Background(), 42)
+	assert.True(t, goodreads.IsNotFound(err))
+}
+
+func TestClient_WorkEditionsPager(t *testing.T) {
+	responseBody := bytes.NewBufferString(workEditionsResponseBody)
+	transport := new(fakes.FakeRoundTripper)
+	transport.RoundTripReturns(&http.Response{
+		Body:       ioutil.NopCloser(responseBody),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	client := goodreads.Client{Client: &http.Client{Transport: transport}, Key: "key"}
+	pager := client.WorkEditionsPager(42)
+
+	var formats []string
+	for pager.Next(context.Background()) {
+		formats = append(formats, pager.Item().(goodreads.Book).Format)
+	}
+
+	assert.Nil(t, pager.Err())
+	assert.Equal(t, formats, []string{"Paperback", "Kindle"})
+	assert.Equal(t, transport.RoundTripCallCount(), 1)
+}
+
+const workEditionsResponseBody string = `
+	<GoodreadsResponse>
+		<editions start="41" end="42" total="42">
+			<book>
+				<id>123</id>
+				<title>baz bar</title>
+				<format>Paperback</format>
+			</book>
+			<book>
+				<id>124</id>
+				<title>baz bar</title>
+				<format>Kindle</format>
+			</book>
+		</editions>
+	</GoodreadsResponse>
+`